@@ -18,6 +18,8 @@ func (p *Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
 	return []*analysis.Analyzer{analyzer.New().Analyzer()}, nil
 }
 
+// GetLoadMode requests type information, which checks like slice elements and
+// composite literal kinds rely on to tell what they are looking at.
 func (f *Plugin) GetLoadMode() string {
-	return register.LoadModeSyntax
+	return register.LoadModeTypesInfo
 }
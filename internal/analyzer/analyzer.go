@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
+	"strconv"
 	"strings"
+	"sync"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -24,22 +27,35 @@ type Logger interface {
 type Analyzer struct {
 	analyzer *analysis.Analyzer
 
-	cfg         *config.SortConfig
+	// carried records the diagnostics of each package whose fix is carried by the fix
+	// of an enclosing group, see withNestedFixes
+	carried map[*types.Package]map[token.Pos]bool
+	cfg     *config.SortConfig
+	// collectors collect the fixes of nested groups by pass, see nestedFixes
+	collectors  map[*analysis.Pass]*[]nestedFix
 	diagnostics []Diagnostic
-	logger      Logger
+	// fixFailures counts the fixes of each package dropped because they failed verification
+	fixFailures map[*types.Package]int
+	// layouts caches the structs of each package whose field order matters, see layoutReason
+	layouts map[*types.Package]map[*types.Struct]string
+	logger  Logger
+	// modules caches the module path of each package, see modulePath
+	modules map[*types.Package]string
+	// mu guards the caches and diagnostics, packages are analyzed concurrently
+	mu sync.Mutex
 }
 
 func New() *Analyzer {
 	analyzer := &analysis.Analyzer{
 		Doc:              "Checks and fixes sorting of Go code elements",
 		Name:             "sortir",
-		Requires:         []*analysis.Analyzer{inspect.Analyzer},
 		RunDespiteErrors: false,
 		URL:              "go.tomakado.io/sortir",
 	}
 
 	a := &Analyzer{analyzer: analyzer}
 	a.initCfg()
+	a.setRequires()
 	a.logger = log.NewLogger(a.cfg.LogLevel())
 
 	analyzer.Run = a.run
@@ -49,6 +65,8 @@ func New() *Analyzer {
 
 func (a *Analyzer) WithConfig(cfg *config.SortConfig) *Analyzer {
 	a.cfg = cfg
+	a.cfg.ApplyDeprecated()
+	a.setRequires()
 	return a
 }
 
@@ -60,6 +78,10 @@ func (a *Analyzer) run(pass *analysis.Pass) (any, error) {
 	a.logger.Verbose("Starting analysis", log.FieldPackage, pass.Pkg.Path())
 	a.logger.Verbose("Config", "config", a.cfg)
 
+	if err := a.validateConfig(); err != nil {
+		return nil, err
+	}
+
 	inspectorObj := pass.ResultOf[inspect.Analyzer]
 
 	inspector, ok := inspectorObj.(*inspector.Inspector)
@@ -74,16 +96,89 @@ func (a *Analyzer) run(pass *analysis.Pass) (any, error) {
 		(*ast.InterfaceType)(nil),
 		(*ast.CallExpr)(nil),
 		(*ast.CompositeLit)(nil),
+		(*ast.SwitchStmt)(nil),
 	}
 
 	inspector.Preorder(nodeFilter, func(n ast.Node) {
 		a.CheckNode(pass, n)
 	})
 
-	a.logger.Verbose("Analysis complete", log.FieldPackage, pass.Pkg.Path())
+	a.mu.Lock()
+	fixFailures := a.fixFailures[pass.Pkg]
+	delete(a.carried, pass.Pkg)
+	delete(a.fixFailures, pass.Pkg)
+	delete(a.layouts, pass.Pkg)
+	delete(a.modules, pass.Pkg)
+	a.mu.Unlock()
+
+	a.logger.Verbose("Analysis complete", log.FieldPackage, pass.Pkg.Path(), log.FieldFixFailures, fixFailures)
 	return nil, nil
 }
 
+// validateConfig rejects settings the checks cannot make sense of, which would
+// otherwise be ignored silently.
+func (a *Analyzer) validateConfig() error {
+	if a.cfg.Imports != nil && a.cfg.Imports.Enabled {
+		if _, err := parseImportSections(a.cfg.Imports.Sections); err != nil {
+			return fmt.Errorf("invalid %s: %w", config.FlagImportsSections, err)
+		}
+	}
+
+	if a.cfg.InterfaceMethods != nil {
+		if err := validateEmbedded(a.cfg.InterfaceMethods.Embedded); err != nil {
+			return fmt.Errorf("invalid %s: %w", config.FlagInterfaceMethodsEmbedded, err)
+		}
+	}
+
+	if a.cfg.StructFields != nil {
+		if err := validateEmbedded(a.cfg.StructFields.Embedded); err != nil {
+			return fmt.Errorf("invalid %s: %w", config.FlagStructFieldsEmbedded, err)
+		}
+	}
+
+	if a.cfg.MapLiterals != nil {
+		if err := validateOrder(a.cfg.MapLiterals.Order); err != nil {
+			return fmt.Errorf("invalid %s: %w", config.FlagMapLiteralsOrder, err)
+		}
+	}
+
+	if a.cfg.StructLiterals != nil {
+		if err := validateOrder(a.cfg.StructLiterals.Order); err != nil {
+			return fmt.Errorf("invalid %s: %w", config.FlagStructLiteralsOrder, err)
+		}
+	}
+
+	if a.cfg.SwitchCases != nil {
+		if err := validateOrder(a.cfg.SwitchCases.Order); err != nil {
+			return fmt.Errorf("invalid %s: %w", config.FlagSwitchCasesOrder, err)
+		}
+	}
+
+	return nil
+}
+
+// validateEmbedded returns an error unless the value is a placement of embedded
+// elements. An empty value stands for the inline placement.
+func validateEmbedded(value string) error {
+	switch value {
+	case "", config.EmbeddedFirst, config.EmbeddedInline, config.EmbeddedLast:
+		return nil
+	default:
+		return fmt.Errorf("unknown embedded placement: %q", value)
+	}
+}
+
+// validateOrder returns an error unless the value is an element order. An empty
+// value stands for the alphabetical order.
+func validateOrder(value string) error {
+	switch value {
+	case "", config.OrderAlphabetical, config.OrderDeclaration:
+		return nil
+	default:
+		return fmt.Errorf("unknown order: %q", value)
+	}
+}
+
 func (a *Analyzer) initCfg() {
 	a.cfg = config.New()
 
@@ -101,6 +196,20 @@ func (a *Analyzer) initCfg() {
 		"ignore sorting checks for specific groups",
 	)
 
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.FixSideEffects,
+		config.FlagFixSideEffects,
+		config.Default[bool](config.FlagFixSideEffects),
+		"suggest fixes for groups whose values have side effects, changing the order they happen in",
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.VerifyFixes,
+		config.FlagVerifyFixes,
+		config.Default[bool](config.FlagVerifyFixes),
+		"drop suggested fixes that do not parse or type-check once applied",
+	)
+
 	a.analyzer.Flags.BoolVar(
 		&a.cfg.Verbose,
 		config.FlagVerbose,
@@ -122,6 +231,34 @@ func (a *Analyzer) initCfg() {
 		"only check sorting for constants starting with specified prefix",
 	)
 
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.Imports.Enabled,
+		config.FlagImports,
+		config.Default[bool](config.FlagImports),
+		"enable import group layout checks",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.Imports.Module,
+		config.FlagImportsModule,
+		config.Default[string](config.FlagImportsModule),
+		"path of the current module for the module import section (detected from go.mod by default)",
+	)
+
+	a.analyzer.Flags.Func(
+		config.FlagImportsSections,
+		"comma-separated import sections in order: std, third-party, module, prefix(<path>) (default \"std,third-party,module\")",
+		func(value string) error {
+			a.cfg.Imports.Sections = nil
+			if err := a.cfg.Imports.Sections.Set(value); err != nil {
+				return err
+			}
+
+			_, err := parseImportSections(a.cfg.Imports.Sections)
+			return err
+		},
+	)
+
 	a.analyzer.Flags.BoolVar(
 		&a.cfg.Variables.Enabled,
 		config.FlagVariables,
@@ -150,6 +287,35 @@ func (a *Analyzer) initCfg() {
 		"only check sorting for struct fields starting with specified prefix",
 	)
 
+	a.analyzer.Flags.Func(
+		config.FlagStructFieldsEmbedded,
+		"placement of embedded fields: inline, first or last (default \"inline\")",
+		func(value string) error {
+			a.cfg.StructFields.Embedded = value
+			return validateEmbedded(value)
+		},
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.StructTags.Enabled,
+		config.FlagStructTags,
+		config.Default[bool](config.FlagStructTags),
+		"enable struct tag key sorting checks",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.StructTags.Prefix,
+		config.FlagStructTagsPrefix,
+		config.Default[string](config.FlagStructTagsPrefix),
+		"only check struct tags of fields starting with specified prefix",
+	)
+
+	a.analyzer.Flags.Var(
+		&a.cfg.StructTags.Order,
+		config.FlagStructTagsOrder,
+		"comma-separated order of struct tag keys (e.g. json,yaml,db,validate), other keys follow alphabetically",
+	)
+
 	a.analyzer.Flags.BoolVar(
 		&a.cfg.InterfaceMethods.Enabled,
 		config.FlagInterfaceMethods,
@@ -164,6 +330,74 @@ func (a *Analyzer) initCfg() {
 		"only check sorting for interface methods starting with specified prefix",
 	)
 
+	a.analyzer.Flags.Func(
+		config.FlagInterfaceMethodsEmbedded,
+		"placement of embedded interfaces: inline, first or last (default \"inline\")",
+		func(value string) error {
+			a.cfg.InterfaceMethods.Embedded = value
+			return validateEmbedded(value)
+		},
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.SwitchCases.Enabled,
+		config.FlagSwitchCases,
+		config.Default[bool](config.FlagSwitchCases),
+		"enable switch case sorting checks",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.SwitchCases.Prefix,
+		config.FlagSwitchCasesPrefix,
+		config.Default[string](config.FlagSwitchCasesPrefix),
+		"only check sorting for switch cases starting with specified prefix",
+	)
+
+	a.analyzer.Flags.Func(
+		config.FlagSwitchCasesOrder,
+		"order of switch cases: alphabetical or declaration (constant cases only) (default \"alphabetical\")",
+		func(value string) error {
+			a.cfg.SwitchCases.Order = value
+			a.setRequires()
+			return validateOrder(value)
+		},
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.TestCases.Enabled,
+		config.FlagTestCases,
+		config.Default[bool](config.FlagTestCases),
+		"enable table-driven test case sorting checks",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.TestCases.Prefix,
+		config.FlagTestCasesPrefix,
+		config.Default[string](config.FlagTestCasesPrefix),
+		"only check sorting for test cases with key starting with specified prefix",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.TestCases.Field,
+		config.FlagTestCasesField,
+		config.Default[string](config.FlagTestCasesField),
+		"struct field to sort table-driven test cases by",
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.UnionTerms.Enabled,
+		config.FlagUnionTerms,
+		config.Default[bool](config.FlagUnionTerms),
+		"enable union term sorting checks in constraint interfaces",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.UnionTerms.Prefix,
+		config.FlagUnionTermsPrefix,
+		config.Default[string](config.FlagUnionTermsPrefix),
+		"only check sorting for union terms starting with specified prefix",
+	)
+
 	a.analyzer.Flags.BoolVar(
 		&a.cfg.VariadicArgs.Enabled,
 		config.FlagVariadicArgs,
@@ -178,18 +412,157 @@ func (a *Analyzer) initCfg() {
 		"only check sorting for variadic arguments starting with specified prefix",
 	)
 
+	a.analyzer.Flags.Var(
+		&a.cfg.VariadicArgs.Functions,
+		config.FlagVariadicArgsFunctions,
+		"comma-separated patterns of functions to check variadic arguments for (e.g. errors.Join,(*github.com/x/y.Router).Use), all if empty",
+	)
+
+	a.analyzer.Flags.Var(
+		&a.cfg.VariadicArgs.Exclude,
+		config.FlagVariadicArgsExclude,
+		"comma-separated patterns of functions to never check variadic arguments for (e.g. fmt.*,append,path/filepath.Join)",
+	)
+
+	a.analyzer.Flags.Var(
+		&a.cfg.VariadicArgs.Options,
+		config.FlagVariadicArgsOptions,
+		"comma-separated patterns of functions whose functional option arguments are sorted by option constructor name",
+	)
+
+	a.analyzer.Flags.Func(
+		config.FlagVariadicArgsPairs,
+		"comma-separated patterns of functions taking alternating key/value variadic arguments (default: slog and zap sugared logger)",
+		func(value string) error {
+			a.cfg.VariadicArgs.Pairs = nil
+			return a.cfg.VariadicArgs.Pairs.Set(value)
+		},
+	)
+
 	a.analyzer.Flags.BoolVar(
-		&a.cfg.MapKeys.Enabled,
-		config.FlagMapKeys,
-		config.Default[bool](config.FlagMapKeys),
-		"enable map value sorting checks",
+		&a.cfg.MapLiterals.Enabled,
+		config.FlagMapLiterals,
+		config.Default[bool](config.FlagMapLiterals),
+		"enable map literal key sorting checks",
 	)
 
 	a.analyzer.Flags.StringVar(
-		&a.cfg.MapKeys.Prefix,
+		&a.cfg.MapLiterals.Prefix,
+		config.FlagMapLiteralsPrefix,
+		config.Default[string](config.FlagMapLiteralsPrefix),
+		"only check sorting for map literal keys starting with specified prefix",
+	)
+
+	a.analyzer.Flags.Func(
+		config.FlagMapLiteralsOrder,
+		"order of map literal keys: alphabetical or declaration (constant keys only) (default \"alphabetical\")",
+		func(value string) error {
+			a.cfg.MapLiterals.Order = value
+			a.setRequires()
+			return validateOrder(value)
+		},
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.MapLiterals.ResolveConstants,
+		config.FlagMapLiteralsResolveConstants,
+		config.Default[bool](config.FlagMapLiteralsResolveConstants),
+		"compare map literal keys referring to constants by their values",
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.StructLiterals.Enabled,
+		config.FlagStructLiterals,
+		config.Default[bool](config.FlagStructLiterals),
+		"enable keyed struct literal field sorting checks",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.StructLiterals.Prefix,
+		config.FlagStructLiteralsPrefix,
+		config.Default[string](config.FlagStructLiteralsPrefix),
+		"only check sorting for struct literal fields starting with specified prefix",
+	)
+
+	a.analyzer.Flags.Func(
+		config.FlagStructLiteralsOrder,
+		"order of keyed struct literal fields: alphabetical or declaration (default \"alphabetical\")",
+		func(value string) error {
+			a.cfg.StructLiterals.Order = value
+			return validateOrder(value)
+		},
+	)
+
+	a.analyzer.Flags.BoolFunc(
+		config.FlagMapKeys,
+		"deprecated: use -"+config.FlagMapLiterals+", -"+config.FlagStructLiterals+" and -"+config.FlagIndexedArrays,
+		func(value string) error {
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+
+			a.cfg.MapLiterals.Enabled, a.cfg.StructLiterals.Enabled, a.cfg.IndexedArrays.Enabled = enabled, enabled, enabled
+			return nil
+		},
+	)
+
+	a.analyzer.Flags.Func(
 		config.FlagMapKeysPrefix,
-		config.Default[string](config.FlagMapKeysPrefix),
-		"only check sorting for map values starting with specified prefix",
+		"deprecated: use -"+config.FlagMapLiteralsPrefix+", -"+config.FlagStructLiteralsPrefix+" and -"+config.FlagIndexedArraysPrefix,
+		func(value string) error {
+			a.cfg.MapLiterals.Prefix, a.cfg.StructLiterals.Prefix, a.cfg.IndexedArrays.Prefix = value, value, value
+			return nil
+		},
+	)
+
+	a.analyzer.Flags.Func(
+		config.FlagCompositeLiteralsOrder,
+		"deprecated: use -"+config.FlagStructLiteralsOrder,
+		func(value string) error {
+			a.cfg.StructLiterals.Order = value
+			return validateOrder(value)
+		},
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.IndexedArrays.Enabled,
+		config.FlagIndexedArrays,
+		config.Default[bool](config.FlagIndexedArrays),
+		"enable indexed array and slice literal element sorting checks",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.IndexedArrays.Prefix,
+		config.FlagIndexedArraysPrefix,
+		config.Default[string](config.FlagIndexedArraysPrefix),
+		"only check sorting for indexed array elements with index starting with specified prefix",
+	)
+
+	a.analyzer.Flags.BoolVar(
+		&a.cfg.SliceElements.Enabled,
+		config.FlagSliceElements,
+		config.Default[bool](config.FlagSliceElements),
+		"enable slice and array element sorting checks",
+	)
+
+	a.analyzer.Flags.StringVar(
+		&a.cfg.SliceElements.Prefix,
+		config.FlagSliceElementsPrefix,
+		config.Default[string](config.FlagSliceElementsPrefix),
+		"only check sorting for slice elements starting with specified prefix",
+	)
+
+	a.analyzer.Flags.Var(
+		&a.cfg.SliceElements.Types,
+		config.FlagSliceElementsTypes,
+		"comma-separated element type patterns of slice literals to check (e.g. string,*.Kind)",
+	)
+
+	a.analyzer.Flags.Var(
+		&a.cfg.SliceElements.Variables,
+		config.FlagSliceElementsVariables,
+		"comma-separated variable name patterns of slice literals to check (e.g. allowed*)",
 	)
 }
 
@@ -210,6 +583,9 @@ func (a *Analyzer) CheckNode(pass *analysis.Pass, node ast.Node) bool {
 	case *ast.CompositeLit:
 		a.logger.Verbose("Checking CompositeLit node", log.FieldPosition, pass.Fset.Position(n.Pos()))
 		return a.checkCompositeLit(pass, n)
+	case *ast.SwitchStmt:
+		a.logger.Verbose("Checking SwitchStmt node", log.FieldPosition, pass.Fset.Position(n.Pos()))
+		return a.checkSwitchStmt(pass, n)
 	}
 	return true
 }
@@ -234,7 +610,15 @@ func (a *Analyzer) CheckCompositeLit(pass *analysis.Pass, node *ast.CompositeLit
 	return a.checkCompositeLit(pass, node)
 }
 
+func (a *Analyzer) CheckSwitchStmt(pass *analysis.Pass, node *ast.SwitchStmt) bool {
+	return a.checkSwitchStmt(pass, node)
+}
+
 func (a *Analyzer) checkGenDecl(pass *analysis.Pass, node *ast.GenDecl) bool {
+	if node.Tok == token.IMPORT {
+		return a.checkImports(pass, node)
+	}
+
 	var prefix string
 	switch node.Tok {
 	case token.CONST:
@@ -259,19 +643,28 @@ func (a *Analyzer) checkGenDecl(pass *analysis.Pass, node *ast.GenDecl) bool {
 		valueSpecs = append(valueSpecs, spec.(*ast.ValueSpec))
 	}
 
+	if isConst && hasPositionalValues(pass, valueSpecs) {
+		a.logger.Verbose("Skipping constants - values depend on position (iota or implicit repetition)", log.FieldPosition, pass.Fset.Position(node.Pos()))
+		return true
+	}
+
 	a.logger.Verbose("Extracting metadata", log.FieldSpecsCount, len(valueSpecs), log.FieldIgnoreGroups, a.cfg.IgnoreGroups)
 	metadata := extractMetadata(pass, valueSpecs, extractGenDecl, a.cfg.IgnoreGroups)
 	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
-	return a.checkElementsSorted(
+	specsSorted := a.checkElementsSorted(
 		pass,
 		metadata,
 		prefix,
 		"variable/constant declarations are not sorted",
 	)
+	namesSorted := a.checkNamesSorted(pass, metadata, prefix, "variable/constant declarations are not sorted")
+	return specsSorted && namesSorted
 }
 
 type checkParams struct {
+	anchored     bool
 	countField   string
+	embedded     string
 	enabled      bool
 	errorMessage string
 	extractFunc  func(*analysis.Pass, *ast.Field) (string, token.Pos, int)
@@ -290,18 +683,40 @@ func (a *Analyzer) checkFieldList(pass *analysis.Pass, params checkParams) bool
 
 	a.logger.Verbose("Extracting metadata", params.countField, len(params.fieldList), log.FieldIgnoreGroups, a.cfg.IgnoreGroups)
 	metadata := extractMetadata(pass, params.fieldList, params.extractFunc, a.cfg.IgnoreGroups)
+	if params.anchored {
+		metadata = splitAtAnchors(pass, metadata)
+	}
+
+	if params.embedded == config.EmbeddedFirst || params.embedded == config.EmbeddedLast {
+		rankEmbedded(metadata, params.embedded)
+	}
+
 	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, params.prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
-	return a.checkElementsSorted(
+	fieldsSorted := a.checkElementsSorted(
 		pass,
 		metadata,
 		params.prefix,
 		params.errorMessage,
 	)
+	namesSorted := a.checkNamesSorted(pass, metadata, params.prefix, params.errorMessage)
+	return fieldsSorted && namesSorted
 }
 
 func (a *Analyzer) checkStructType(pass *analysis.Pass, node *ast.StructType) bool {
+	fieldsSorted := a.checkStructFields(pass, node)
+	tagsSorted := a.checkStructTags(pass, node)
+	return fieldsSorted && tagsSorted
+}
+
+func (a *Analyzer) checkStructFields(pass *analysis.Pass, node *ast.StructType) bool {
+	if a.cfg.StructFields.Enabled && !a.checkLayout(pass, node) {
+		return true
+	}
+
 	return a.checkFieldList(pass, checkParams{
+		anchored:     true,
 		countField:   log.FieldFieldsCount,
+		embedded:     a.cfg.StructFields.Embedded,
 		enabled:      a.cfg.StructFields.Enabled,
 		errorMessage: "struct fields are not sorted",
 		extractFunc:  extractStructField,
@@ -313,8 +728,15 @@ func (a *Analyzer) checkStructType(pass *analysis.Pass, node *ast.StructType) bo
 }
 
 func (a *Analyzer) checkInterfaceType(pass *analysis.Pass, node *ast.InterfaceType) bool {
+	methodsSorted := a.checkInterfaceMethods(pass, node)
+	termsSorted := a.checkUnionTerms(pass, node)
+	return methodsSorted && termsSorted
+}
+
+func (a *Analyzer) checkInterfaceMethods(pass *analysis.Pass, node *ast.InterfaceType) bool {
 	return a.checkFieldList(pass, checkParams{
 		countField:   log.FieldMethodsCount,
+		embedded:     a.cfg.InterfaceMethods.Embedded,
 		enabled:      a.cfg.InterfaceMethods.Enabled,
 		errorMessage: "interface methods are not sorted",
 		extractFunc:  extractInterfaceMethod,
@@ -325,6 +747,38 @@ func (a *Analyzer) checkInterfaceType(pass *analysis.Pass, node *ast.InterfaceTy
 	})
 }
 
+func (a *Analyzer) checkUnionTerms(pass *analysis.Pass, node *ast.InterfaceType) bool {
+	cfg := a.cfg.UnionTerms
+	if cfg == nil || !cfg.Enabled {
+		a.logger.Verbose("Skipping union term checks")
+		return true
+	}
+
+	a.logger.Verbose("Processing union terms", log.FieldEnabled, cfg.Enabled, log.FieldPrefix, cfg.Prefix)
+
+	allSorted := true
+	for _, field := range node.Methods.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+
+		terms := unionTerms(field.Type)
+		if len(terms) <= 1 {
+			continue
+		}
+
+		// Each union is checked as a group of its own
+		a.logger.Verbose("Extracting metadata", log.FieldTermsCount, len(terms))
+		metadata := extractMetadata(pass, terms, extractUnionTerm, true)
+		a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, cfg.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
+		if !a.checkElementsSorted(pass, metadata, cfg.Prefix, "union terms are not sorted") {
+			allSorted = false
+		}
+	}
+
+	return allSorted
+}
+
 func (a *Analyzer) checkCallExpr(pass *analysis.Pass, node *ast.CallExpr) bool {
 	a.logger.Verbose("Processing variadic arguments", log.FieldEnabled, a.cfg.VariadicArgs.Enabled, log.FieldPrefix, a.cfg.VariadicArgs.Prefix)
 	if !a.cfg.VariadicArgs.Enabled {
@@ -332,8 +786,29 @@ func (a *Analyzer) checkCallExpr(pass *analysis.Pass, node *ast.CallExpr) bool {
 		return true
 	}
 
+	names := calleeNames(pass, node)
+	if len(a.cfg.VariadicArgs.Functions) > 0 && !a.cfg.VariadicArgs.Functions.Match(names...) {
+		a.logger.Verbose("Skipping call - function is not in the list", log.FieldFunction, names)
+		return true
+	}
+
+	if a.cfg.VariadicArgs.Exclude.Match(names...) {
+		a.logger.Verbose("Skipping call - function is excluded", log.FieldFunction, names)
+		return true
+	}
+
+	if a.cfg.VariadicArgs.Pairs.Match(names...) {
+		return a.checkVariadicPairs(pass, node)
+	}
+
 	a.logger.Verbose("Extracting metadata", log.FieldArgsCount, len(node.Args), log.FieldIgnoreGroups, a.cfg.IgnoreGroups)
-	metadata := extractVariadicArgMetadata(pass, node, a.cfg.IgnoreGroups)
+	var metadata [][]Metadata
+	if a.cfg.VariadicArgs.Options.Match(names...) {
+		a.logger.Verbose("Sorting functional options by constructor name", log.FieldFunction, names)
+		metadata = extractVariadicOptionMetadata(pass, node, a.cfg.IgnoreGroups)
+	} else {
+		metadata = extractVariadicArgMetadata(pass, node, a.cfg.IgnoreGroups)
+	}
 	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, a.cfg.VariadicArgs.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
 	return a.checkElementsSorted(
 		pass,
@@ -343,13 +818,54 @@ func (a *Analyzer) checkCallExpr(pass *analysis.Pass, node *ast.CallExpr) bool {
 	)
 }
 
+func (a *Analyzer) checkVariadicPairs(pass *analysis.Pass, node *ast.CallExpr) bool {
+	a.logger.Verbose("Extracting key/value pair metadata", log.FieldArgsCount, len(node.Args), log.FieldIgnoreGroups, a.cfg.IgnoreGroups)
+	metadata := extractVariadicPairMetadata(pass, node, a.cfg.IgnoreGroups)
+	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, a.cfg.VariadicArgs.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
+	return a.checkElementsSorted(
+		pass,
+		metadata,
+		a.cfg.VariadicArgs.Prefix,
+		"variadic key/value pairs are not sorted",
+	)
+}
+
 func (a *Analyzer) checkCompositeLit(pass *analysis.Pass, node *ast.CompositeLit) bool {
-	a.logger.Verbose("Processing map keys", "composite_lit_type", fmt.Sprintf("%#v", node.Type), log.FieldEnabled, a.cfg.MapKeys.Enabled, log.FieldPrefix, a.cfg.MapKeys.Prefix)
-	if !a.cfg.MapKeys.Enabled {
-		a.logger.Verbose("Skipping map key checks")
+	keysSorted := a.checkCompositeLitKeys(pass, node)
+	elementsSorted := a.checkSliceElements(pass, node)
+	testCasesSorted := a.checkTestCases(pass, node)
+	return keysSorted && elementsSorted && testCasesSorted
+}
+
+func (a *Analyzer) checkCompositeLitKeys(pass *analysis.Pass, node *ast.CompositeLit) bool {
+	var cfg *config.CheckConfig
+	var msg string
+
+	kind := compositeLitKindOf(pass, node)
+	switch kind {
+	case compositeLitMap:
+		if a.cfg.MapLiterals != nil {
+			cfg = &a.cfg.MapLiterals.CheckConfig
+		}
+		msg = "map literal keys are not sorted"
+	case compositeLitStruct:
+		if a.cfg.StructLiterals != nil {
+			cfg = &a.cfg.StructLiterals.CheckConfig
+		}
+		msg = "struct literal fields are not sorted"
+	case compositeLitIndexed:
+		cfg, msg = a.cfg.IndexedArrays, "indexed array elements are not sorted"
+	default:
+		return true
+	}
+
+	if cfg == nil || !cfg.Enabled {
+		a.logger.Verbose("Skipping composite literal key checks", log.FieldKind, kind)
 		return true
 	}
 
+	a.logger.Verbose("Processing composite literal keys", log.FieldKind, kind, log.FieldEnabled, cfg.Enabled, log.FieldPrefix, cfg.Prefix)
+
 	keyValueExprs := make([]*ast.KeyValueExpr, 0, len(node.Elts))
 	for _, elt := range node.Elts {
 		if kv, ok := elt.(*ast.KeyValueExpr); ok {
@@ -359,18 +875,104 @@ func (a *Analyzer) checkCompositeLit(pass *analysis.Pass, node *ast.CompositeLit
 
 	a.logger.Verbose("Extracting metadata", log.FieldKeyValueCount, len(keyValueExprs), log.FieldIgnoreGroups, a.cfg.IgnoreGroups)
 	metadata := extractMetadata(pass, keyValueExprs, extractMapKey, a.cfg.IgnoreGroups)
-	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, a.cfg.MapKeys.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
+	if kind != compositeLitStruct {
+		setNumericKeys(pass, metadata)
+	}
+
+	if kind == compositeLitMap && a.cfg.MapLiterals.ResolveConstants {
+		a.logger.Verbose("Resolving constant map keys")
+		resolveConstantKeys(pass, metadata)
+	}
+
+	if kind == compositeLitMap && a.cfg.MapLiterals.Order == config.OrderDeclaration {
+		a.logger.Verbose("Using declaration order of constant map keys", log.FieldOrder, a.cfg.MapLiterals.Order)
+		a.rankByConstDeclaration(pass, metadata)
+	}
+
+	if kind == compositeLitStruct && a.cfg.StructLiterals.Order == config.OrderDeclaration {
+		if fields, ok := structFieldIndexes(pass, node); ok {
+			a.logger.Verbose("Using declaration order of struct fields", log.FieldOrder, a.cfg.StructLiterals.Order)
+			rankByDeclaration(metadata, fields)
+		}
+	}
+
+	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, cfg.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
 	return a.checkElementsSorted(
 		pass,
 		metadata,
-		a.cfg.MapKeys.Prefix,
-		"composite literal elements are not sorted",
+		cfg.Prefix,
+		msg,
+	)
+}
+
+func (a *Analyzer) checkSliceElements(pass *analysis.Pass, node *ast.CompositeLit) bool {
+	cfg := a.cfg.SliceElements
+	if cfg == nil || !cfg.Enabled {
+		a.logger.Verbose("Skipping slice element checks")
+		return true
+	}
+
+	elemType, ok := sliceElemType(pass, node)
+	if !ok || len(node.Elts) <= 1 {
+		return true
+	}
+
+	if !cfg.Types.Match(typeNames(pass, elemType)...) && !cfg.Variables.Match(literalTargetName(pass, node)) {
+		a.logger.Verbose("Skipping slice literal - no matching type or variable pattern", log.FieldPosition, pass.Fset.Position(node.Pos()))
+		return true
+	}
+
+	for _, elt := range node.Elts {
+		switch elt.(type) {
+		case *ast.BasicLit, *ast.Ident:
+		default:
+			a.logger.Verbose("Skipping slice literal - not all elements are literals or identifiers", log.FieldPosition, pass.Fset.Position(elt.Pos()))
+			return true
+		}
+	}
+
+	a.logger.Verbose("Processing slice elements", log.FieldEnabled, cfg.Enabled, log.FieldPrefix, cfg.Prefix)
+	a.logger.Verbose("Extracting metadata", log.FieldElementsCount, len(node.Elts), log.FieldIgnoreGroups, a.cfg.IgnoreGroups)
+	metadata := extractMetadata(pass, node.Elts, extractSliceElement, a.cfg.IgnoreGroups)
+	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, cfg.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
+	return a.checkElementsSorted(
+		pass,
+		metadata,
+		cfg.Prefix,
+		"slice elements are not sorted",
 	)
 }
 
 func (a *Analyzer) report(pass *analysis.Pass, diagnostic Diagnostic) {
+	if a.collect(pass, diagnostic) {
+		return
+	}
+
+	if diagnostic.HasFixSuggestion() && a.isCarried(pass, diagnostic.From) {
+		a.logger.Verbose("Withholding fix - the fix of the enclosing group sorts these elements too", log.FieldPosition, pass.Fset.Position(diagnostic.From))
+		diagnostic.Suggestion = nil
+	}
+
+	if a.cfg.VerifyFixes && diagnostic.HasFixSuggestion() {
+		if err := a.verifyFix(pass, diagnostic.Suggestion); err != nil {
+			a.mu.Lock()
+			if a.fixFailures == nil {
+				a.fixFailures = make(map[*types.Package]int)
+			}
+			a.fixFailures[pass.Pkg]++
+			fixFailures := a.fixFailures[pass.Pkg]
+			a.mu.Unlock()
+
+			a.logger.Verbose("Dropping fix - verification failed", log.FieldError, err, log.FieldFixFailures, fixFailures)
+			diagnostic.Message += droppedFixNote(err)
+			diagnostic.Suggestion = nil
+		}
+	}
+
 	a.logger.Verbose("Reporting diagnostic", log.FieldDiagnostic, diagnostic)
+	a.mu.Lock()
 	a.diagnostics = append(a.diagnostics, diagnostic)
+	a.mu.Unlock()
 
 	pass.Report(diagnostic.AsGoAnalysisDiagnostic())
 }
@@ -401,12 +1003,12 @@ func (a *Analyzer) checkElementsSorted(
 		var unsortedIndex int
 
 		for i := 1; i < len(group); i++ {
-			if !hasPrefixOrGlobal(group[i].Value, prefix, a.cfg.GlobalPrefix) {
-				a.logger.Verbose("Skipping element - no matching prefix", log.FieldElement, group[i].Value, log.FieldPrefix, prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
+			if !hasPrefixOrGlobal(group[i].prefixValue(), prefix, a.cfg.GlobalPrefix) {
+				a.logger.Verbose("Skipping element - no matching prefix", log.FieldElement, group[i].prefixValue(), log.FieldPrefix, prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
 				continue
 			}
 
-			if group[i].Value < group[i-1].Value {
+			if lessMetadata(group[i], group[i-1]) {
 				allSorted = false
 				groupNeedsSorting = true
 				unsortedIndex = i
@@ -424,6 +1026,7 @@ func (a *Analyzer) checkElementsSorted(
 			fix := a.generateFix(pass, group, elementType)
 
 			a.report(pass, Diagnostic{
+				Category:   getCategory(msg),
 				From:       group[unsortedIndex].Position,
 				Message:    msg,
 				Suggestion: fix,
@@ -431,7 +1034,11 @@ func (a *Analyzer) checkElementsSorted(
 		}
 	}
 
-	a.logger.Verbose("Sorting check complete", log.FieldDiagnosticsCount, len(a.diagnostics), log.FieldAllSorted, allSorted)
+	a.mu.Lock()
+	diagnosticsCount := len(a.diagnostics)
+	a.mu.Unlock()
+
+	a.logger.Verbose("Sorting check complete", log.FieldDiagnosticsCount, diagnosticsCount, log.FieldAllSorted, allSorted)
 	return allSorted
 }
 
@@ -454,6 +1061,23 @@ func hasPrefix(name, prefix string) bool {
 	return strings.HasPrefix(name, prefix)
 }
 
+// getCategory returns the rule ID of the check reporting the message, which is
+// the name of its flag. Messages shared by several checks have no rule ID.
+func getCategory(msg string) string {
+	switch msg {
+	case "map literal keys are not sorted":
+		return config.FlagMapLiterals
+	case "struct literal fields are not sorted":
+		return config.FlagStructLiterals
+	case "indexed array elements are not sorted":
+		return config.FlagIndexedArrays
+	case "switch cases are not sorted":
+		return config.FlagSwitchCases
+	default:
+		return ""
+	}
+}
+
 func getElementType(msg string) string {
 	switch msg {
 	case "variable/constant declarations are not sorted":
@@ -464,8 +1088,22 @@ func getElementType(msg string) string {
 		return "interface methods"
 	case "variadic arguments are not sorted":
 		return "variadic arguments"
-	case "composite literal elements are not sorted":
-		return "map keys"
+	case "variadic key/value pairs are not sorted":
+		return "key/value pairs"
+	case "map literal keys are not sorted":
+		return "map literal keys"
+	case "struct literal fields are not sorted":
+		return "struct literal fields"
+	case "indexed array elements are not sorted":
+		return "indexed array elements"
+	case "slice elements are not sorted":
+		return "slice elements"
+	case "union terms are not sorted":
+		return "union terms"
+	case "test cases are not sorted":
+		return "test cases"
+	case "switch cases are not sorted":
+		return "switch cases"
 	default:
 		return "elements"
 	}
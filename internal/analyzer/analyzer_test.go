@@ -32,6 +32,9 @@ func TestAnalyzer(t *testing.T) {
 			"interfaces",
 			"variadic/disabled",
 			"map_keys",
+			"union_terms",
+			"embedded/inline",
+			"layout",
 		)
 	})
 
@@ -44,6 +47,142 @@ func TestAnalyzer(t *testing.T) {
 
 		analysistest.Run(t, testdata, a.Analyzer(),
 			"variadic/enabled",
+			"variadic/pairs",
+		)
+	})
+
+	t.Run("variadic options", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.VariadicArgs.Enabled = true
+		cfg.VariadicArgs.Options = config.Patterns{"options.New"}
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"variadic/options",
+		)
+	})
+
+	t.Run("variadic functions", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.VariadicArgs.Enabled = true
+		cfg.VariadicArgs.Functions = config.Patterns{"errors.Join", "(*functions.Router).*", "fmt.*", "append"}
+		cfg.VariadicArgs.Exclude = config.Patterns{"(*functions.Router).Handle", "fmt.Println", "append"}
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"variadic/functions",
+		)
+	})
+
+	t.Run("slice elements", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.SliceElements.Types = config.Patterns{"*.Kind"}
+		cfg.SliceElements.Variables = config.Patterns{"allowed*"}
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"slice_elements",
+		)
+	})
+
+	t.Run("resolve constants", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.Constants.Enabled = false
+		cfg.MapLiterals.ResolveConstants = true
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"map_keys/constants",
+		)
+	})
+
+	t.Run("embedded first", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.InterfaceMethods.Embedded = config.EmbeddedFirst
+		cfg.StructFields.Embedded = config.EmbeddedFirst
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"embedded/first",
+		)
+	})
+
+	t.Run("enum declaration order", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.Constants.Enabled = false
+		cfg.MapLiterals.Order = config.OrderDeclaration
+		cfg.SwitchCases.Enabled = true
+		cfg.SwitchCases.Order = config.OrderDeclaration
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"enum_order",
+		)
+	})
+
+	t.Run("declaration order", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.StructLiterals.Order = config.OrderDeclaration
+		cfg.StructFields.Enabled = false
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"declaration_order",
+		)
+	})
+
+	t.Run("test cases", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.TestCases.Enabled = true
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"test_cases",
+		)
+	})
+
+	t.Run("struct tags", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.StructTags.Enabled = true
+		cfg.StructTags.Order = config.List{"json", "yaml", "db", "validate"}
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"struct_tags",
+		)
+	})
+
+	t.Run("imports", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.Imports.Enabled = true
+		cfg.Imports.Module = "imports"
+		cfg.Imports.Sections = config.List{"std", "third-party", "prefix(github.com/acme)", "module"}
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(),
+			"imports/cascade",
+			"imports/grouped",
+			"imports/misplaced",
 		)
 	})
 }
@@ -98,12 +237,24 @@ func TestAnalyzerWithPrefix(t *testing.T) {
 		t.Parallel()
 
 		cfg := config.New()
-		cfg.MapKeys.Prefix = "Pref"
+		cfg.MapLiterals.Prefix = "Pref"
 		a := analyzer.New().WithConfig(cfg)
 
 		analysistest.Run(t, testdata, a.Analyzer(), "filterprefix/maps")
 	})
 
+	t.Run("maps with resolved constants", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New()
+		cfg.Constants.Enabled = false
+		cfg.MapLiterals.Prefix = "Pref"
+		cfg.MapLiterals.ResolveConstants = true
+		a := analyzer.New().WithConfig(cfg)
+
+		analysistest.Run(t, testdata, a.Analyzer(), "filterprefix/resolved")
+	})
+
 	t.Run("variadic", func(t *testing.T) {
 		t.Parallel()
 
@@ -578,9 +729,11 @@ func TestCheckStructType(t *testing.T) {
 
 		testStructTypeSorting(t, testParams{
 			cfg: &config.SortConfig{
-				StructFields: &config.CheckConfig{
-					Enabled: true,
-					Prefix:  "f",
+				StructFields: &config.FieldsConfig{
+					CheckConfig: config.CheckConfig{
+						Enabled: true,
+						Prefix:  "f",
+					},
 				},
 				GlobalPrefix: "",
 				IgnoreGroups: false,
@@ -601,8 +754,10 @@ type MyStruct struct {
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			StructFields: &config.CheckConfig{
-				Enabled: false,
+			StructFields: &config.FieldsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: false,
+				},
 			},
 			IgnoreGroups: false,
 		}
@@ -636,9 +791,11 @@ type MyStruct struct {
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			StructFields: &config.CheckConfig{
-				Enabled: true,
-				Prefix:  "f",
+			StructFields: &config.FieldsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: true,
+					Prefix:  "f",
+				},
 			},
 			GlobalPrefix: "",
 			IgnoreGroups: false,
@@ -673,9 +830,11 @@ type MyStruct struct {
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			StructFields: &config.CheckConfig{
-				Enabled: true,
-				Prefix:  "f",
+			StructFields: &config.FieldsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: true,
+					Prefix:  "f",
+				},
 			},
 			GlobalPrefix: "",
 			IgnoreGroups: false,
@@ -709,9 +868,11 @@ type MyStruct struct {
 
 		testStructTypeSorting(t, testParams{
 			cfg: &config.SortConfig{
-				StructFields: &config.CheckConfig{
-					Enabled: true,
-					Prefix:  "",
+				StructFields: &config.FieldsConfig{
+					CheckConfig: config.CheckConfig{
+						Enabled: true,
+						Prefix:  "",
+					},
 				},
 				GlobalPrefix: "",
 				IgnoreGroups: true,
@@ -735,9 +896,11 @@ type MyStruct struct {
 
 		testStructTypeSorting(t, testParams{
 			cfg: &config.SortConfig{
-				StructFields: &config.CheckConfig{
-					Enabled: true,
-					Prefix:  "",
+				StructFields: &config.FieldsConfig{
+					CheckConfig: config.CheckConfig{
+						Enabled: true,
+						Prefix:  "",
+					},
 				},
 				GlobalPrefix: "",
 				IgnoreGroups: false,
@@ -766,9 +929,11 @@ func TestCheckInterfaceType(t *testing.T) {
 
 		testInterfaceTypeSorting(t, testParams{
 			cfg: &config.SortConfig{
-				InterfaceMethods: &config.CheckConfig{
-					Enabled: true,
-					Prefix:  "M",
+				InterfaceMethods: &config.FieldsConfig{
+					CheckConfig: config.CheckConfig{
+						Enabled: true,
+						Prefix:  "M",
+					},
 				},
 				GlobalPrefix: "",
 				IgnoreGroups: false,
@@ -789,8 +954,10 @@ type MyInterface interface {
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			InterfaceMethods: &config.CheckConfig{
-				Enabled: false,
+			InterfaceMethods: &config.FieldsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: false,
+				},
 			},
 			IgnoreGroups: false,
 		}
@@ -824,9 +991,11 @@ type MyInterface interface {
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			InterfaceMethods: &config.CheckConfig{
-				Enabled: true,
-				Prefix:  "M",
+			InterfaceMethods: &config.FieldsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: true,
+					Prefix:  "M",
+				},
 			},
 			GlobalPrefix: "",
 			IgnoreGroups: false,
@@ -860,9 +1029,11 @@ type MyInterface interface {
 
 		testInterfaceTypeSorting(t, testParams{
 			cfg: &config.SortConfig{
-				InterfaceMethods: &config.CheckConfig{
-					Enabled: true,
-					Prefix:  "",
+				InterfaceMethods: &config.FieldsConfig{
+					CheckConfig: config.CheckConfig{
+						Enabled: true,
+						Prefix:  "",
+					},
 				},
 				GlobalPrefix: "",
 				IgnoreGroups: true,
@@ -886,9 +1057,11 @@ type MyInterface interface {
 
 		testInterfaceTypeSorting(t, testParams{
 			cfg: &config.SortConfig{
-				InterfaceMethods: &config.CheckConfig{
-					Enabled: true,
-					Prefix:  "",
+				InterfaceMethods: &config.FieldsConfig{
+					CheckConfig: config.CheckConfig{
+						Enabled: true,
+						Prefix:  "",
+					},
 				},
 				GlobalPrefix: "",
 				IgnoreGroups: false,
@@ -916,9 +1089,11 @@ func TestCheckCallExpr(t *testing.T) {
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			VariadicArgs: &config.CheckConfig{
-				Enabled: true,
-				Prefix:  "",
+			VariadicArgs: &config.VariadicArgsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: true,
+					Prefix:  "",
+				},
 			},
 			GlobalPrefix: "",
 			IgnoreGroups: false,
@@ -959,8 +1134,10 @@ func test() {
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			VariadicArgs: &config.CheckConfig{
-				Enabled: false,
+			VariadicArgs: &config.VariadicArgsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: false,
+				},
 			},
 			IgnoreGroups: false,
 		}
@@ -997,9 +1174,11 @@ func test() {
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			VariadicArgs: &config.CheckConfig{
-				Enabled: true,
-				Prefix:  "",
+			VariadicArgs: &config.VariadicArgsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: true,
+					Prefix:  "",
+				},
 			},
 			GlobalPrefix: "",
 			IgnoreGroups: false,
@@ -1042,9 +1221,10 @@ func TestCheckCompositeLit(t *testing.T) {
 
 		testCompositeLitSorting(t, testParams{
 			cfg: &config.SortConfig{
-				MapKeys: &config.CheckConfig{
-					Enabled: true,
-					Prefix:  "",
+				MapLiterals: &config.MapLiteralsConfig{
+					CheckConfig: config.CheckConfig{
+						Enabled: true,
+					},
 				},
 				GlobalPrefix: "",
 				IgnoreGroups: false,
@@ -1057,7 +1237,7 @@ var m = map[string]int{
 	"a": 1,
 }
 `,
-			errorMessage: "composite literal elements are not sorted",
+			errorMessage: "map literal keys are not sorted",
 		}, false)
 	})
 
@@ -1065,8 +1245,10 @@ var m = map[string]int{
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			MapKeys: &config.CheckConfig{
-				Enabled: false,
+			MapLiterals: &config.MapLiteralsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: false,
+				},
 			},
 			IgnoreGroups: false,
 		}
@@ -1100,9 +1282,16 @@ var m = map[string]int{
 		t.Parallel()
 
 		cfg := &config.SortConfig{
-			MapKeys: &config.CheckConfig{
-				Enabled: true,
-				Prefix:  "",
+			MapLiterals: &config.MapLiteralsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: true,
+				},
+			},
+			StructLiterals: &config.StructLiteralsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: true,
+					Prefix:  "",
+				},
 			},
 			GlobalPrefix: "",
 			IgnoreGroups: false,
@@ -1160,4 +1349,80 @@ var m = map[string]int{
 		require.False(t, result, "map literal with unsorted keys should return false")
 		require.Len(t, getDiagnostics(pass2), 1, "should have one diagnostic for unsorted map keys")
 	})
+
+	t.Run("indexed array", func(t *testing.T) {
+		t.Parallel()
+
+		testCompositeLitSorting(t, testParams{
+			cfg: &config.SortConfig{
+				IndexedArrays: &config.CheckConfig{
+					Enabled: true,
+				},
+			},
+			src: `
+package test
+
+var a = [...]string{
+	2: "b",
+	1: "a",
+}
+`,
+			errorMessage: "indexed array elements are not sorted",
+		}, false)
+	})
+
+	t.Run("independent checks", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.SortConfig{
+			IndexedArrays: &config.CheckConfig{
+				Enabled: false,
+			},
+			MapLiterals: &config.MapLiteralsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: true,
+				},
+			},
+			StructLiterals: &config.StructLiteralsConfig{
+				CheckConfig: config.CheckConfig{
+					Enabled: false,
+				},
+			},
+		}
+		a := analyzer.New().WithConfig(cfg)
+
+		src := `
+package test
+
+type T struct {
+	A int
+	B int
+}
+
+var s = T{B: 2, A: 1}
+
+var a = []string{2: "b", 1: "a"}
+
+var m = map[string]T{"b": {}, "a": {}}
+`
+		pass := createPass(t, src)
+
+		var literals []*ast.CompositeLit
+		ast.Inspect(pass.Files[0], func(n ast.Node) bool {
+			if cl, ok := n.(*ast.CompositeLit); ok {
+				literals = append(literals, cl)
+				return false
+			}
+			return true
+		})
+
+		require.True(t, a.CheckCompositeLit(pass, literals[0]), "struct literals are disabled")
+		require.True(t, a.CheckCompositeLit(pass, literals[1]), "indexed arrays are disabled")
+		require.False(t, a.CheckCompositeLit(pass, literals[2]), "map literals are enabled")
+
+		diagnostics := getDiagnostics(pass)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, "map literal keys are not sorted", diagnostics[0].Message)
+		require.Equal(t, config.FlagMapLiterals, diagnostics[0].Category)
+	})
 }
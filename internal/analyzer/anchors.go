@@ -0,0 +1,67 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// splitAtAnchors splits groups of struct fields at anchor fields, which stay in
+// place: fields are only sorted within the run following each anchor, like the
+// fields guarded by a mutex declared right above them.
+func splitAtAnchors(pass *analysis.Pass, groups [][]Metadata) [][]Metadata {
+	var result [][]Metadata
+	for _, group := range groups {
+		var run []Metadata
+		for _, meta := range group {
+			field, ok := meta.Node.(*ast.Field)
+			if !ok || !isAnchorField(pass, field) {
+				run = append(run, meta)
+				continue
+			}
+
+			if len(run) > 0 {
+				result = append(result, run)
+			}
+			run = nil
+		}
+
+		if len(run) > 0 {
+			result = append(result, run)
+		}
+	}
+
+	return result
+}
+
+// isAnchorField reports whether the field anchors the fields following it: a
+// sync.Mutex or sync.RWMutex, or a marker field like "_ [0]func()" or "noCopy noCopy".
+func isAnchorField(pass *analysis.Pass, field *ast.Field) bool {
+	if len(field.Names) > 0 {
+		blank := true
+		for _, name := range field.Names {
+			blank = blank && name.Name == "_"
+		}
+
+		if blank {
+			return true
+		}
+	}
+
+	if pass.TypesInfo == nil {
+		return false
+	}
+
+	t := pass.TypesInfo.TypeOf(field.Type)
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	if isNamedType(t, "sync", "Mutex") || isNamedType(t, "sync", "RWMutex") {
+		return true
+	}
+
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Name() == "noCopy"
+}
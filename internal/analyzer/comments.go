@@ -0,0 +1,332 @@
+package analyzer
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// commented holds the comments moving together with a multi-line element: comment
+// groups written directly above it, where the element starts, and the comment
+// following it on its last line.
+type commented struct {
+	comment *ast.CommentGroup
+	start   token.Pos
+}
+
+func (c commented) comments() commented {
+	return c
+}
+
+// commentedNode is an element that moves together with its comments.
+type commentedNode interface {
+	ast.Node
+	comments() commented
+}
+
+// commentedEnd returns the end of the element including its trailing comment.
+func commentedEnd(node commentedNode) token.Pos {
+	if comment := node.comments().comment; comment != nil {
+		return comment.End()
+	}
+
+	return node.End()
+}
+
+// collectComments returns the comments of the consecutive elements placed between from and to.
+func collectComments(pass *analysis.Pass, from, to token.Pos, nodes []ast.Node) []commented {
+	result := make([]commented, len(nodes))
+	for i, node := range nodes {
+		result[i].start = node.Pos()
+	}
+
+	file := fileOf(pass, from)
+	if file == nil {
+		return result
+	}
+
+	line := func(pos token.Pos) int {
+		return pass.Fset.Position(pos).Line
+	}
+
+	for i, node := range nodes {
+		prevEnd, nextStart := from, to
+		if i > 0 {
+			prevEnd = nodes[i-1].End()
+		}
+		if i < len(nodes)-1 {
+			nextStart = nodes[i+1].Pos()
+		}
+
+		for j := len(file.Comments) - 1; j >= 0; j-- {
+			group := file.Comments[j]
+			if group.Pos() <= prevEnd || group.End() > result[i].start {
+				continue
+			}
+
+			if line(group.End()) == line(result[i].start)-1 && line(group.Pos()) > line(prevEnd) {
+				result[i].start = group.Pos()
+			}
+		}
+
+		for _, group := range file.Comments {
+			if group.Pos() > node.End() && group.End() < nextStart && line(group.Pos()) == line(node.End()) {
+				result[i].comment = group
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// generateCommentedFix moves every element of the group together with its comments,
+// keeping the original source of the elements, which are placed on their own lines
+// and followed by the separator.
+func (a *Analyzer) generateCommentedFix(pass *analysis.Pass, original, sorted []Metadata, separator string) ([]byte, token.Pos, token.Pos) {
+	first, last := original[0].Node.(commentedNode), original[len(original)-1].Node.(commentedNode)
+	if pass.ReadFile == nil {
+		return nil, 0, 0
+	}
+
+	content, file := a.getFileContent(pass, first.comments().start)
+	if content == nil || file == nil {
+		return nil, 0, 0
+	}
+
+	if hasUnattachedComments(pass, original) {
+		a.logger.Verbose("Skipping fix - group contains comments not attached to elements")
+		return nil, 0, 0
+	}
+
+	source := func(from, to token.Pos) string {
+		return string(content[file.Offset(from):file.Offset(to)])
+	}
+
+	// The replaced range ends after the separator following the last element, if any,
+	// so that elements with a trailing comment can be moved to the end
+	from, to := first.comments().start, commentedEnd(last)
+	trailingSeparator := separator == "" || last.comments().comment != nil
+	if !trailingSeparator {
+		rest := content[file.Offset(last.End()):]
+		if trimmed := bytes.TrimLeft(rest, " \t"); bytes.HasPrefix(trimmed, []byte(separator)) {
+			to = last.End() + token.Pos(len(rest)-len(trimmed)+len(separator))
+			trailingSeparator = true
+		}
+	}
+
+	lineStart := file.Offset(file.LineStart(file.Line(from)))
+	indent := string(content[lineStart:file.Offset(from)])
+	if strings.TrimSpace(indent) != "" {
+		return nil, 0, 0
+	}
+
+	var buf strings.Builder
+	for i, meta := range sorted {
+		node := meta.Node.(commentedNode)
+		if i > 0 {
+			buf.WriteString("\n" + indent)
+		}
+
+		buf.WriteString(a.elementSource(pass, node, source))
+		if i < len(sorted)-1 || trailingSeparator {
+			buf.WriteString(separator)
+		}
+
+		if comment := node.comments().comment; comment != nil {
+			if !trailingSeparator && i == len(sorted)-1 {
+				return nil, 0, 0
+			}
+			buf.WriteString(" " + source(comment.Pos(), comment.End()))
+		}
+	}
+
+	return []byte(buf.String()), from, to
+}
+
+// hasUnattachedComments reports whether there are comments between the elements of
+// the group that belong to none of them, which a fix would lose.
+func hasUnattachedComments(pass *analysis.Pass, group []Metadata) bool {
+	file := fileOf(pass, group[0].Position)
+	if file == nil {
+		return true
+	}
+
+	first, last := group[0].Node.(commentedNode), group[len(group)-1].Node.(commentedNode)
+	for _, comment := range file.Comments {
+		if comment.Pos() < first.comments().start || comment.End() > commentedEnd(last) {
+			continue
+		}
+
+		attached := false
+		for _, meta := range group {
+			node := meta.Node.(commentedNode)
+			if comment.Pos() >= node.comments().start && comment.End() <= commentedEnd(node) {
+				attached = true
+				break
+			}
+		}
+
+		if !attached {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasCommentsWithin reports whether there are comments between from and to.
+func hasCommentsWithin(pass *analysis.Pass, from, to token.Pos) bool {
+	file := fileOf(pass, from)
+	if file == nil {
+		return false
+	}
+
+	for _, comment := range file.Comments {
+		if comment.Pos() >= from && comment.End() <= to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// commentedElement is a value spec, field or key/value element moving together
+// with its comments.
+type commentedElement struct {
+	ast.Node
+	commented
+}
+
+// generateAttachedCommentsFix moves elements placed on their own lines together
+// with their doc and trailing comments. It returns no fix if elements share lines.
+// Comment lines split these groups, so the comment above the first element is a
+// header of the group, like "// HTTP codes", and stays in place.
+func (a *Analyzer) generateAttachedCommentsFix(pass *analysis.Pass, original, sorted []Metadata, separator string) ([]byte, token.Pos, token.Pos) {
+	if pass.ReadFile == nil {
+		return nil, 0, 0
+	}
+
+	comments := attachedComments(pass, original)
+	header := comments[original[0].Node]
+	header.start = original[0].Node.Pos()
+	comments[original[0].Node] = header
+
+	wrap := func(group []Metadata) []Metadata {
+		wrapped := make([]Metadata, len(group))
+		for i, meta := range group {
+			wrapped[i] = meta
+			wrapped[i].Node = commentedElement{Node: meta.Node, commented: comments[meta.Node]}
+		}
+
+		return wrapped
+	}
+
+	wrappedOriginal := wrap(original)
+	for i := 1; i < len(wrappedOriginal); i++ {
+		prev, cur := wrappedOriginal[i-1].Node.(commentedElement), wrappedOriginal[i].Node.(commentedElement)
+		if pass.Fset.Position(cur.start).Line <= pass.Fset.Position(commentedEnd(prev)).Line {
+			return nil, 0, 0
+		}
+	}
+
+	return a.generateCommentedFix(pass, wrappedOriginal, wrap(sorted), separator)
+}
+
+// attachedComments returns the comments of the elements of the group: the Doc and
+// Comment groups of specs and fields, and the comments written around key/value
+// elements, which have none in the syntax tree.
+func attachedComments(pass *analysis.Pass, group []Metadata) map[ast.Node]commented {
+	result := make(map[ast.Node]commented, len(group))
+	var others []ast.Node
+	for _, meta := range group {
+		switch n := meta.Node.(type) {
+		case *ast.ValueSpec:
+			result[n] = docComments(n, n.Doc, n.Comment)
+		case *ast.Field:
+			result[n] = docComments(n, n.Doc, n.Comment)
+		default:
+			others = append(others, n)
+		}
+	}
+
+	if len(others) > 0 {
+		from, to := elementBounds(pass, others)
+		for i, c := range collectComments(pass, from, to, others) {
+			result[others[i]] = c
+		}
+	}
+
+	return result
+}
+
+func docComments(node ast.Node, doc, comment *ast.CommentGroup) commented {
+	result := commented{comment: comment, start: node.Pos()}
+	if doc != nil {
+		result.start = doc.Pos()
+	}
+
+	return result
+}
+
+// elementBounds returns the end of the element preceding the consecutive composite
+// literal elements and the start of the one following them, or the brace if there
+// is none. Comments outside of the bounds do not belong to the elements.
+func elementBounds(pass *analysis.Pass, nodes []ast.Node) (token.Pos, token.Pos) {
+	first, last := nodes[0], nodes[len(nodes)-1]
+	from, to := first.Pos(), last.End()
+
+	file := fileOf(pass, first.Pos())
+	if file == nil {
+		return from, to
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, first.Pos(), last.End())
+	for _, node := range path {
+		lit, ok := node.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+
+		from, to = lit.Lbrace, lit.Rbrace
+		for i, elt := range lit.Elts {
+			if elt == first && i > 0 {
+				from = lit.Elts[i-1].End()
+			}
+			if elt == last && i < len(lit.Elts)-1 {
+				to = lit.Elts[i+1].Pos()
+			}
+		}
+
+		break
+	}
+
+	return from, to
+}
+
+// elementSource returns the source of an element from the start of its comments
+// to its end, with the names of multi-name specs and fields sorted.
+func (a *Analyzer) elementSource(pass *analysis.Pass, node commentedNode, source func(from, to token.Pos) string) string {
+	start := node.comments().start
+	element, ok := node.(commentedElement)
+	if !ok {
+		return source(start, node.End())
+	}
+
+	switch n := element.Node.(type) {
+	case *ast.ValueSpec:
+		if len(elementNames(n)) > 1 {
+			return source(start, n.Pos()) + a.sortedSpec(pass, n)
+		}
+	case *ast.Field:
+		if len(n.Names) > 1 {
+			return source(start, n.Pos()) + a.sortedNameList(pass, n.Names) + source(n.Names[len(n.Names)-1].End(), n.End())
+		}
+	}
+
+	return source(start, node.End())
+}
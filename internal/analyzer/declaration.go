@@ -0,0 +1,170 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+
+	"go.tomakado.io/sortir/internal/config"
+	"go.tomakado.io/sortir/internal/log"
+)
+
+// constOrderFact records the position of a typed package-level constant among
+// all constants of its package, in the order they are declared.
+type constOrderFact struct {
+	Index int
+}
+
+func (*constOrderFact) AFact() {}
+
+func (f *constOrderFact) String() string {
+	return fmt.Sprintf("const order %d", f.Index)
+}
+
+// constOrder maps the typed package-level constants of a package and of its
+// dependencies to their position among the constants of their package.
+type constOrder map[*types.Const]int
+
+// constOrderAnalyzer records the declaration order of typed package-level constants,
+// like enum members, so that packages using them can follow it. Facts make the
+// driver run it on every dependency, so sortir only requires it when a check orders
+// elements by declaration.
+var constOrderAnalyzer = &analysis.Analyzer{
+	Doc:        "Records the declaration order of typed package-level constants",
+	Name:       "sortirconstorder",
+	FactTypes:  []analysis.Fact{new(constOrderFact)},
+	ResultType: reflect.TypeOf(constOrder(nil)),
+	Run:        runConstOrder,
+	URL:        "go.tomakado.io/sortir",
+}
+
+func runConstOrder(pass *analysis.Pass) (any, error) {
+	order := make(constOrder)
+	for _, fact := range pass.AllObjectFacts() {
+		if obj, ok := fact.Object.(*types.Const); ok {
+			order[obj] = fact.Fact.(*constOrderFact).Index
+		}
+	}
+
+	index := 0
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					obj, ok := pass.TypesInfo.Defs[name].(*types.Const)
+					if !ok {
+						continue
+					}
+
+					if _, ok := obj.Type().(*types.Named); !ok {
+						continue
+					}
+
+					pass.ExportObjectFact(obj, &constOrderFact{Index: index})
+					order[obj] = index
+					index++
+				}
+			}
+		}
+	}
+
+	return order, nil
+}
+
+// declarationOrderEnabled reports whether any check orders constants by declaration.
+func (a *Analyzer) declarationOrderEnabled() bool {
+	if a.cfg.MapLiterals != nil && a.cfg.MapLiterals.Order == config.OrderDeclaration {
+		return true
+	}
+
+	return a.cfg.SwitchCases != nil && a.cfg.SwitchCases.Order == config.OrderDeclaration
+}
+
+// setRequires lists the analyzers sortir depends on. The constant order is only
+// computed when it is used, as it makes the driver analyze all dependencies.
+func (a *Analyzer) setRequires() {
+	requires := []*analysis.Analyzer{inspect.Analyzer}
+	if a.declarationOrderEnabled() {
+		requires = append(requires, constOrderAnalyzer)
+	}
+
+	a.analyzer.Requires = requires
+}
+
+// elementKey returns the key expression elements are compared by: the key of a
+// key/value element or the first expression of a case clause.
+func elementKey(node ast.Node) ast.Expr {
+	switch n := node.(type) {
+	case *ast.KeyValueExpr:
+		return n.Key
+	case switchCase:
+		return n.List[0]
+	default:
+		return nil
+	}
+}
+
+// keyConst returns the constant the key refers to, like KindA or http.MethodGet.
+func keyConst(pass *analysis.Pass, key ast.Expr) *types.Const {
+	var ident *ast.Ident
+	switch k := key.(type) {
+	case *ast.Ident:
+		ident = k
+	case *ast.SelectorExpr:
+		ident = k.Sel
+	default:
+		return nil
+	}
+
+	obj, _ := pass.TypesInfo.Uses[ident].(*types.Const)
+	return obj
+}
+
+// rankByConstDeclaration ranks elements whose keys refer to constants by the
+// declaration order of the constants. Groups with keys of unknown order are left
+// in alphabetical order.
+func (a *Analyzer) rankByConstDeclaration(pass *analysis.Pass, groups [][]Metadata) {
+	order, ok := pass.ResultOf[constOrderAnalyzer].(constOrder)
+	if pass.TypesInfo == nil || !ok {
+		return
+	}
+
+	for _, group := range groups {
+		ranks := make([]int, len(group))
+		known := true
+		for i, meta := range group {
+			obj := keyConst(pass, elementKey(meta.Node))
+			if obj == nil {
+				known = false
+				break
+			}
+
+			index, ok := order[obj]
+			if !ok {
+				known = false
+				break
+			}
+
+			ranks[i] = index
+		}
+
+		if !known {
+			a.logger.Verbose("Skipping declaration order - not all keys are ordered constants", log.FieldPosition, pass.Fset.Position(group[0].Position))
+			continue
+		}
+
+		for i := range group {
+			group[i].Rank = ranks[i]
+		}
+	}
+}
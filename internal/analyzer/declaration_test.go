@@ -0,0 +1,42 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"go.tomakado.io/sortir/internal/config"
+)
+
+func TestConstOrderAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), constOrderAnalyzer, "const_order")
+}
+
+func TestSetRequires(t *testing.T) {
+	a := New()
+	require.NotContains(t, a.Analyzer().Requires, constOrderAnalyzer)
+
+	cfg := config.New()
+	cfg.SwitchCases.Order = config.OrderDeclaration
+	require.Contains(t, a.WithConfig(cfg).Analyzer().Requires, constOrderAnalyzer)
+
+	a = New()
+	require.NoError(t, a.Analyzer().Flags.Set(config.FlagMapLiteralsOrder, config.OrderDeclaration))
+	require.Contains(t, a.Analyzer().Requires, constOrderAnalyzer)
+	require.NoError(t, analysis.Validate([]*analysis.Analyzer{a.Analyzer()}))
+}
+
+func TestInvalidOrder(t *testing.T) {
+	a := New()
+	require.Error(t, a.Analyzer().Flags.Set(config.FlagSwitchCasesOrder, "declared"))
+	require.Error(t, a.Analyzer().Flags.Set(config.FlagStructLiteralsOrder, "random"))
+
+	cfg := config.New()
+	cfg.MapLiterals.Order = "Declaration"
+	require.ErrorContains(t, a.WithConfig(cfg).validateConfig(), `unknown order: "Declaration"`)
+
+	cfg.MapLiterals.Order = config.OrderDeclaration
+	require.NoError(t, a.validateConfig())
+}
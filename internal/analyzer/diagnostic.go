@@ -7,6 +7,7 @@ import (
 )
 
 type Diagnostic struct {
+	Category   string
 	From, To   token.Pos
 	Message    string
 	Suggestion *FixSuggestion
@@ -28,7 +29,7 @@ func (d Diagnostic) AsGoAnalysisDiagnostic() analysis.Diagnostic {
 	}
 
 	return analysis.Diagnostic{
-		End: d.To, Message: d.Message, Pos: d.From, SuggestedFixes: suggestedFixes,
+		Category: d.Category, End: d.To, Message: d.Message, Pos: d.From, SuggestedFixes: suggestedFixes,
 	}
 }
 
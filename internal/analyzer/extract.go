@@ -3,22 +3,69 @@ package analyzer
 import (
 	"bytes"
 	"go/ast"
+	"go/constant"
 	"go/printer"
 	"go/token"
 	"go/types"
 	"sort"
 	"strconv"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"go.tomakado.io/sortir/internal/config"
 )
 
 type extractFunc[T ast.Node] func(pass *analysis.Pass, node T) (string, token.Pos, int)
 
+// unionTerm is a term of a type union in a constraint interface, e.g. ~int in ~int | ~string.
+type unionTerm struct {
+	ast.Expr
+}
+
 type Metadata struct {
-	Line     int
-	Node     ast.Node
+	// Key is the key as written when Value holds the value of a constant key
+	// instead, prefixes are matched against it.
+	Key  string
+	Line int
+	Node ast.Node
+	// Number is the value of a numeric key, elements having one are compared numerically.
+	Number   constant.Value
 	Position token.Pos
-	Value    string
+	// Rank orders elements before their values are compared, e.g. by the
+	// declaration order of struct fields. It is zero for alphabetical order.
+	Rank  int
+	Value string
+}
+
+// lessMetadata reports whether the left element goes before the right one.
+// Elements with numeric keys go before the others, so that groups mixing
+// both kinds of keys have a consistent order.
+func lessMetadata(left, right Metadata) bool {
+	if left.Rank != right.Rank {
+		return left.Rank < right.Rank
+	}
+
+	if (left.Number != nil) != (right.Number != nil) {
+		return left.Number != nil
+	}
+
+	if left.Number != nil {
+		return constant.Compare(left.Number, token.LSS, right.Number)
+	}
+
+	return left.Value < right.Value
+}
+
+// prefixValue returns the text prefixes are matched against.
+func (m Metadata) prefixValue() string {
+	if m.Key != "" {
+		return m.Key
+	}
+
+	return m.Value
 }
 
 func extractVariadicArgMetadata(
@@ -35,6 +82,154 @@ func extractVariadicArgMetadata(
 	return extractMetadata(pass, variadicArgs, extractVariadicArg, groupByEmptyLine)
 }
 
+func extractVariadicOptionMetadata(
+	pass *analysis.Pass,
+	callExpr *ast.CallExpr,
+	groupByEmptyLine bool,
+) [][]Metadata {
+
+	variadicArgs, ok := extractVariadicArgs(pass, callExpr)
+	if !ok {
+		return [][]Metadata{}
+	}
+
+	return extractMetadata(pass, variadicArgs, extractVariadicOption, groupByEmptyLine)
+}
+
+// variadicPair is a key/value pair of slog-style variadic arguments. Attribute
+// arguments like slog.String("k", v) form a pair on their own and have no Value.
+type variadicPair struct {
+	Key   ast.Expr
+	Value ast.Expr
+}
+
+func (p variadicPair) Pos() token.Pos {
+	return p.Key.Pos()
+}
+
+func (p variadicPair) End() token.Pos {
+	if p.Value != nil {
+		return p.Value.End()
+	}
+
+	return p.Key.End()
+}
+
+// attrTypes are types of variadic arguments that carry both key and value.
+var attrTypes = map[string]bool{
+	"go.uber.org/zap/zapcore.Field": true,
+	"log/slog.Attr":                 true,
+}
+
+func extractVariadicPairMetadata(
+	pass *analysis.Pass,
+	callExpr *ast.CallExpr,
+	groupByEmptyLine bool,
+) [][]Metadata {
+
+	variadicArgs, ok := extractVariadicArgs(pass, callExpr)
+	if !ok {
+		return [][]Metadata{}
+	}
+
+	pairs, ok := variadicPairs(pass, variadicArgs)
+	if !ok {
+		return [][]Metadata{}
+	}
+
+	return extractMetadata(pass, pairs, extractVariadicPair, groupByEmptyLine)
+}
+
+// variadicPairs reads arguments the same way slog does: a string is a key followed
+// by its value, an attribute is a pair on its own. Any other argument makes
+// the keys unknown, so no pairs are returned.
+func variadicPairs(pass *analysis.Pass, args []ast.Expr) ([]variadicPair, bool) {
+	var pairs []variadicPair
+	for i := 0; i < len(args); {
+		switch {
+		case isAttrArg(pass, args[i]):
+			pairs = append(pairs, variadicPair{Key: args[i]})
+			i++
+		case isStringArg(pass, args[i]) && i+1 < len(args):
+			pairs = append(pairs, variadicPair{Key: args[i], Value: args[i+1]})
+			i += 2
+		default:
+			return nil, false
+		}
+	}
+
+	return pairs, true
+}
+
+func isAttrArg(pass *analysis.Pass, arg ast.Expr) bool {
+	named, ok := types.Unalias(pass.TypesInfo.TypeOf(arg)).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return attrTypes[named.Obj().Pkg().Path()+"."+named.Obj().Name()]
+}
+
+func isStringArg(pass *analysis.Pass, arg ast.Expr) bool {
+	basic, ok := pass.TypesInfo.TypeOf(arg).(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
+
+func extractVariadicPair(pass *analysis.Pass, node variadicPair) (string, token.Pos, int) {
+	key := node.Key
+	if call, ok := key.(*ast.CallExpr); ok && node.Value == nil && len(call.Args) > 0 {
+		// Attribute constructor like slog.String("k", v), the key is its first argument
+		key = call.Args[0]
+	}
+
+	var value string
+	if tv, ok := pass.TypesInfo.Types[key]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		value = constant.StringVal(tv.Value)
+	} else {
+		var buf bytes.Buffer
+		_ = printer.Fprint(&buf, token.NewFileSet(), key)
+		value = buf.String()
+	}
+
+	pos := node.Pos()
+	line := pass.Fset.File(pos).Line(pos)
+	return value, pos, line
+}
+
+// calleeNames returns the names of the called function, method or builtin, which are
+// used to match function patterns from config.
+func calleeNames(pass *analysis.Pass, callExpr *ast.CallExpr) []string {
+	if pass.TypesInfo == nil {
+		return nil
+	}
+
+	switch callee := typeutil.Callee(pass.TypesInfo, callExpr).(type) {
+	case *types.Func:
+		return funcNames(callee)
+	case *types.Builtin:
+		return []string{callee.Name()}
+	}
+
+	return nil
+}
+
+// funcNames returns the names of a function or method qualified by package path,
+// e.g. (*log/slog.Logger).Info, and by package name, e.g. (*slog.Logger).Info,
+// which are used to match function patterns from config.
+func funcNames(fn *types.Func) []string {
+	byName := func(pkg *types.Package) string { return pkg.Name() }
+
+	if recv := fn.Signature().Recv(); recv != nil {
+		return []string{fn.FullName(), "(" + types.TypeString(recv.Type(), byName) + ")." + fn.Name()}
+	}
+
+	if fn.Pkg() == nil {
+		return []string{fn.Name()}
+	}
+
+	return []string{fn.FullName(), fn.Pkg().Name() + "." + fn.Name()}
+}
+
 func extractVariadicArgs(pass *analysis.Pass, callExpr *ast.CallExpr) ([]ast.Expr, bool) {
 	// To properly extract variadic arguments, we need to:
 	// 1. Find the function's type signature
@@ -123,10 +318,7 @@ func extractMetadata[T ast.Node](
 	currentGroup := []Metadata{allData[0]}
 
 	for i := 1; i < len(allData); i++ {
-		// Check if there's at least one empty line between elements
-		lineDiff := allData[i].Line - allData[i-1].Line
-
-		if lineDiff > 1 {
+		if hasEmptyLineBetween(pass, allData[i-1], allData[i]) {
 			// Empty line detected
 			result = append(result, currentGroup)
 			currentGroup = []Metadata{allData[i]}
@@ -143,17 +335,65 @@ func extractMetadata[T ast.Node](
 	return result
 }
 
+// endLine returns the line the element ends on, which differs from its start line
+// for elements spanning several lines.
+func endLine(pass *analysis.Pass, meta Metadata) int {
+	return max(meta.Line, pass.Fset.Position(meta.Node.End()).Line)
+}
+
+// hasEmptyLineBetween reports whether a line separates two consecutive elements.
+// Lines holding comments count too, so comments like section headers split groups.
+func hasEmptyLineBetween(pass *analysis.Pass, prev, next Metadata) bool {
+	return next.Line-endLine(pass, prev) > 1
+}
+
 func extractMapKey(pass *analysis.Pass, node *ast.KeyValueExpr) (string, token.Pos, int) {
-	value := getKeyString(node.Key)
+	var value string
+	if !isComplexKey(pass, node.Key) {
+		value = getKeyString(node.Key)
+	}
+
 	pos := node.Key.Pos()
 	line := pass.Fset.File(pos).Line(pos)
 	return value, pos, line
 }
 
+func extractSliceElement(pass *analysis.Pass, node ast.Expr) (string, token.Pos, int) {
+	value := getKeyString(node)
+	pos := node.Pos()
+	line := pass.Fset.File(pos).Line(pos)
+	return value, pos, line
+}
+
+// unionTerms flattens a union type expression like ~int | ~int64 | float64 into its terms.
+func unionTerms(expr ast.Expr) []unionTerm {
+	binary, ok := expr.(*ast.BinaryExpr)
+	if !ok || binary.Op != token.OR {
+		return []unionTerm{{Expr: expr}}
+	}
+
+	return append(unionTerms(binary.X), unionTerms(binary.Y)...)
+}
+
+func extractUnionTerm(pass *analysis.Pass, node unionTerm) (string, token.Pos, int) {
+	// The tilde does not take part in comparison
+	term := node.Expr
+	if unary, ok := term.(*ast.UnaryExpr); ok && unary.Op == token.TILDE {
+		term = unary.X
+	}
+
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, token.NewFileSet(), term)
+
+	pos := node.Pos()
+	line := pass.Fset.File(pos).Line(pos)
+	return buf.String(), pos, line
+}
+
 func extractStructField(pass *analysis.Pass, node *ast.Field) (string, token.Pos, int) {
 	var value string
 	if len(node.Names) > 0 {
-		value = node.Names[0].Name
+		value = firstName(node.Names)
 	} else {
 		value = getTypeString(node.Type)
 	}
@@ -164,6 +404,9 @@ func extractStructField(pass *analysis.Pass, node *ast.Field) (string, token.Pos
 
 func extractGenDecl(pass *analysis.Pass, node *ast.ValueSpec) (string, token.Pos, int) {
 	value := node.Names[0].Name
+	if names := elementNames(node); names != nil {
+		value = firstName(names)
+	}
 	pos := node.Names[0].Pos()
 	line := pass.Fset.File(pos).Line(pos)
 	return value, pos, line
@@ -203,7 +446,288 @@ func extractVariadicArg(pass *analysis.Pass, node ast.Expr) (string, token.Pos,
 	return value, pos, line
 }
 
-// getTypeString returns a string representation of a type expression.
+// sliceElemType returns the element type of a slice or array composite literal.
+func sliceElemType(pass *analysis.Pass, lit *ast.CompositeLit) (types.Type, bool) {
+	if pass.TypesInfo == nil {
+		return nil, false
+	}
+
+	typ := pass.TypesInfo.TypeOf(lit)
+	if typ == nil {
+		return nil, false
+	}
+
+	switch t := typ.Underlying().(type) {
+	case *types.Slice:
+		return t.Elem(), true
+	case *types.Array:
+		return t.Elem(), true
+	}
+
+	return nil, false
+}
+
+// typeNames returns the names of a type qualified by package path, by package name
+// and relative to the current package, which are used to match type patterns from config.
+func typeNames(pass *analysis.Pass, typ types.Type) []string {
+	return []string{
+		types.TypeString(typ, nil),
+		types.TypeString(typ, func(pkg *types.Package) string { return pkg.Name() }),
+		types.TypeString(typ, types.RelativeTo(pass.Pkg)),
+	}
+}
+
+// literalTargetName returns the name of the variable, constant or struct field
+// the composite literal is assigned to, or an empty string if there is none.
+func literalTargetName(pass *analysis.Pass, lit *ast.CompositeLit) string {
+	file := fileOf(pass, lit.Pos())
+	if file == nil {
+		return ""
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, lit.Pos(), lit.End())
+	if len(path) < 2 {
+		return ""
+	}
+
+	switch parent := path[1].(type) {
+	case *ast.ValueSpec:
+		for i, value := range parent.Values {
+			if value == lit && i < len(parent.Names) {
+				return parent.Names[i].Name
+			}
+		}
+	case *ast.AssignStmt:
+		for i, rhs := range parent.Rhs {
+			if rhs != lit || i >= len(parent.Lhs) {
+				continue
+			}
+
+			switch lhs := parent.Lhs[i].(type) {
+			case *ast.Ident:
+				return lhs.Name
+			case *ast.SelectorExpr:
+				return lhs.Sel.Name
+			}
+		}
+	case *ast.KeyValueExpr:
+		if key, ok := parent.Key.(*ast.Ident); ok && parent.Value == lit {
+			return key.Name
+		}
+	}
+
+	return ""
+}
+
+// fileOf returns the syntax tree of the pass file containing pos.
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, file := range pass.Files {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file
+		}
+	}
+
+	return nil
+}
+
+// extractVariadicOption uses the name of the called option constructor as the value,
+// e.g. WithTimeout for WithTimeout(5) or server.WithTimeout[int](5). Other arguments
+// are extracted the same way as regular variadic arguments.
+func extractVariadicOption(pass *analysis.Pass, node ast.Expr) (string, token.Pos, int) {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return extractVariadicArg(pass, node)
+	}
+
+	fun := call.Fun
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+
+	var value string
+	switch f := fun.(type) {
+	case *ast.Ident:
+		value = f.Name
+	case *ast.SelectorExpr:
+		value = f.Sel.Name
+	default:
+		return extractVariadicArg(pass, node)
+	}
+
+	pos := node.Pos()
+	line := pass.Fset.File(pos).Line(pos)
+	return value, pos, line
+}
+
+// numericKey returns the value of a numeric key written as a literal expression,
+// like 10, 0x1F, -1.5, 'a' or 1 << 3. Keys referring to named constants are not evaluated.
+func numericKey(pass *analysis.Pass, expr ast.Expr) constant.Value {
+	if pass.TypesInfo == nil || !isLiteralExpr(expr) {
+		return nil
+	}
+
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil {
+		return nil
+	}
+
+	switch tv.Value.Kind() {
+	case constant.Float, constant.Int:
+		return tv.Value
+	default:
+		return nil
+	}
+}
+
+// isLiteralExpr reports whether the expression consists of basic literals only.
+func isLiteralExpr(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.ParenExpr:
+		return isLiteralExpr(e.X)
+	case *ast.UnaryExpr:
+		return isLiteralExpr(e.X)
+	case *ast.BinaryExpr:
+		return isLiteralExpr(e.X) && isLiteralExpr(e.Y)
+	default:
+		return false
+	}
+}
+
+// setNumericKeys sets numbers of elements with numeric literal keys.
+func setNumericKeys(pass *analysis.Pass, groups [][]Metadata) {
+	for _, group := range groups {
+		for i := range group {
+			if key := elementKey(group[i].Node); key != nil {
+				group[i].Number = numericKey(pass, key)
+			}
+		}
+	}
+}
+
+// resolveConstantKeys replaces values of elements whose keys refer to constants,
+// like KindA or http.MethodGet, with the values of the constants. Prefixes still
+// match the keys as written.
+func resolveConstantKeys(pass *analysis.Pass, groups [][]Metadata) {
+	if pass.TypesInfo == nil {
+		return
+	}
+
+	for _, group := range groups {
+		for i := range group {
+			key := elementKey(group[i].Node)
+			if key == nil {
+				continue
+			}
+
+			obj := keyConst(pass, key)
+			if obj == nil {
+				continue
+			}
+
+			group[i].Key = group[i].Value
+			switch val := obj.Val(); val.Kind() {
+			case constant.String:
+				group[i].Value = constant.StringVal(val)
+			case constant.Float, constant.Int:
+				group[i].Number = val
+				group[i].Value = val.ExactString()
+			default:
+				group[i].Value = val.ExactString()
+			}
+		}
+	}
+}
+
+// isComplexKey reports whether the key is a complex number, which has no order.
+func isComplexKey(pass *analysis.Pass, expr ast.Expr) bool {
+	if pass.TypesInfo == nil {
+		return false
+	}
+
+	basic, ok := pass.TypesInfo.TypeOf(expr).(*types.Basic)
+	return ok && basic.Info()&types.IsComplex != 0
+}
+
+// compositeLitKind is the kind of a composite literal with keyed elements.
+type compositeLitKind string
+
+const (
+	compositeLitIndexed compositeLitKind = "indexed array"
+	compositeLitMap     compositeLitKind = "map"
+	compositeLitStruct  compositeLitKind = "struct"
+	compositeLitUnknown compositeLitKind = "unknown"
+)
+
+// compositeLitKindOf tells maps, structs and arrays or slices apart by the type of the literal.
+func compositeLitKindOf(pass *analysis.Pass, lit *ast.CompositeLit) compositeLitKind {
+	if pass.TypesInfo == nil {
+		return compositeLitUnknown
+	}
+
+	typ := pass.TypesInfo.TypeOf(lit)
+	if typ == nil {
+		return compositeLitUnknown
+	}
+
+	if pointer, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = pointer.Elem()
+	}
+
+	switch typ.Underlying().(type) {
+	case *types.Array, *types.Slice:
+		return compositeLitIndexed
+	case *types.Map:
+		return compositeLitMap
+	case *types.Struct:
+		return compositeLitStruct
+	}
+
+	return compositeLitUnknown
+}
+
+// structFieldIndexes returns the declaration indexes of the fields of a struct
+// literal, which may be declared in another package.
+func structFieldIndexes(pass *analysis.Pass, lit *ast.CompositeLit) (map[string]int, bool) {
+	typ := pass.TypesInfo.TypeOf(lit)
+	if typ == nil {
+		return nil, false
+	}
+
+	if pointer, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = pointer.Elem()
+	}
+
+	structType, ok := typ.Underlying().(*types.Struct)
+	if !ok {
+		return nil, false
+	}
+
+	indexes := make(map[string]int, structType.NumFields())
+	for i := range structType.NumFields() {
+		indexes[structType.Field(i).Name()] = i
+	}
+
+	return indexes, true
+}
+
+// rankByDeclaration ranks keyed struct literal elements by the declaration index
+// of their fields.
+func rankByDeclaration(groups [][]Metadata, fields map[string]int) {
+	for _, group := range groups {
+		for i := range group {
+			group[i].Rank = fields[group[i].Value]
+		}
+	}
+}
+
+// getTypeString returns the sort key of a type expression, e.g. of an embedded
+// field. Pointers are stripped and type arguments are rendered, so *pkg.Base
+// becomes pkg.Base and Generic[K,V] becomes Generic[K, V].
 func getTypeString(expr ast.Expr) string {
 	switch typeExpr := expr.(type) {
 	case *ast.Ident:
@@ -212,11 +736,47 @@ func getTypeString(expr ast.Expr) string {
 		if x, ok := typeExpr.X.(*ast.Ident); ok {
 			return x.Name + "." + typeExpr.Sel.Name
 		}
+	case *ast.StarExpr:
+		return getTypeString(typeExpr.X)
+	case *ast.ParenExpr:
+		return getTypeString(typeExpr.X)
+	case *ast.IndexExpr:
+		return getTypeString(typeExpr.X) + "[" + getTypeString(typeExpr.Index) + "]"
+	case *ast.IndexListExpr:
+		args := make([]string, len(typeExpr.Indices))
+		for i, index := range typeExpr.Indices {
+			args[i] = getTypeString(index)
+		}
+		return getTypeString(typeExpr.X) + "[" + strings.Join(args, ", ") + "]"
+	case *ast.ArrayType, *ast.ChanType, *ast.FuncType, *ast.MapType:
+		// Type arguments and type set elements like []byte
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, token.NewFileSet(), expr); err == nil {
+			return buf.String()
+		}
 	}
 
+	// Type set terms like ~int | ~string have no name to sort by
 	return ""
 }
 
+// rankEmbedded ranks embedded fields before or after named ones.
+func rankEmbedded(groups [][]Metadata, placement string) {
+	for _, group := range groups {
+		for i := range group {
+			field, ok := group[i].Node.(*ast.Field)
+			if !ok {
+				continue
+			}
+
+			embedded := len(field.Names) == 0
+			if embedded == (placement == config.EmbeddedLast) {
+				group[i].Rank = 1
+			}
+		}
+	}
+}
+
 // getKeyString extracts a string representation of a map key.
 func getKeyString(expr ast.Expr) string {
 	switch exprVal := expr.(type) {
@@ -231,5 +791,12 @@ func getKeyString(expr ast.Expr) string {
 		return exprVal.Name
 	}
 
-	return ""
+	// Selectors, composite literals, calls and other keys are compared by their
+	// canonical rendering, which does not depend on the original formatting
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), expr); err != nil {
+		return ""
+	}
+
+	return buf.String()
 }
@@ -2,12 +2,16 @@ package analyzer
 
 import (
 	"go/ast"
+	"go/parser"
 	"go/token"
 	"go/types"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/config"
 )
 
 type ExtractTestSuite struct {
@@ -252,6 +256,68 @@ func (s *ExtractTestSuite) TestExtractInterfaceMethod() {
 	}
 }
 
+func (s *ExtractTestSuite) TestExtractUnionTerm() {
+	src := `package p
+
+type Union interface {
+	~int64 | int |
+		~[]byte |
+		pkg.Type
+}`
+	file, err := parser.ParseFile(s.fset, "union.go", src, 0)
+	s.Require().NoError(err)
+
+	iface := file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec).Type.(*ast.InterfaceType)
+	terms := unionTerms(iface.Methods.List[0].Type)
+	s.Require().Len(terms, 4)
+
+	expected := []struct {
+		value string
+		line  int
+	}{
+		{value: "int64", line: 4},
+		{value: "int", line: 4},
+		{value: "[]byte", line: 5},
+		{value: "pkg.Type", line: 6},
+	}
+	for i, term := range terms {
+		value, pos, line := extractUnionTerm(s.pass, term)
+		s.Assert().Equal(expected[i].value, value)
+		s.Assert().Equal(term.Pos(), pos)
+		s.Assert().Equal(expected[i].line, line)
+	}
+
+	s.Run("single term", func() {
+		expr, err := parser.ParseExpr("~string")
+		s.Require().NoError(err)
+		s.Assert().Len(unionTerms(expr), 1)
+	})
+}
+
+func (s *ExtractTestSuite) TestGetTypeString() {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{expr: "Base", expected: "Base"},
+		{expr: "*Base", expected: "Base"},
+		{expr: "pkg.Base", expected: "pkg.Base"},
+		{expr: "*pkg.Base", expected: "pkg.Base"},
+		{expr: "Base[T]", expected: "Base[T]"},
+		{expr: "*pkg.Generic[K,V]", expected: "pkg.Generic[K, V]"},
+		{expr: "Generic[[]byte, *pkg.T]", expected: "Generic[[]byte, pkg.T]"},
+		{expr: "~int | ~string", expected: ""},
+	}
+
+	for _, test := range tests {
+		s.Run(test.expr, func() {
+			expr, err := parser.ParseExpr(test.expr)
+			s.Require().NoError(err)
+			s.Assert().Equal(test.expected, getTypeString(expr))
+		})
+	}
+}
+
 func (s *ExtractTestSuite) TestExtractVariadicArg() {
 	tests := []struct {
 		name     string
@@ -302,6 +368,32 @@ func (s *ExtractTestSuite) TestExtractVariadicArg() {
 	}
 }
 
+func (s *ExtractTestSuite) TestExtractVariadicOption() {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{name: "function call", src: `WithTimeout(5 * time.Second)`, expected: "WithTimeout"},
+		{name: "qualified call", src: `server.WithAddr(":80")`, expected: "WithAddr"},
+		{name: "generic call", src: `WithValue[int](1)`, expected: "WithValue"},
+		{name: "generic call with multiple type arguments", src: `WithPair[int, string](1, "a")`, expected: "WithPair"},
+		{name: "identifier", src: `customOption`, expected: "customOption"},
+		{name: "method value call", src: `opts()()`, expected: "opts()()"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			expr, err := parser.ParseExpr(tt.src)
+			s.Require().NoError(err)
+
+			value, pos, _ := extractVariadicOption(s.pass, expr)
+			s.Assert().Equal(tt.expected, value)
+			s.Assert().Equal(expr.Pos(), pos)
+		})
+	}
+}
+
 func (s *ExtractTestSuite) TestExtractVariadicArgMetadata() {
 	code := `
 	package test
@@ -625,3 +717,15 @@ func testExtractFunc(pass *analysis.Pass, node testNode) (string, token.Pos, int
 func TestExtractTestSuite(t *testing.T) {
 	suite.Run(t, new(ExtractTestSuite))
 }
+
+func TestInvalidEmbedded(t *testing.T) {
+	a := New()
+	require.Error(t, a.Analyzer().Flags.Set(config.FlagStructFieldsEmbedded, "top"))
+
+	cfg := config.New()
+	cfg.InterfaceMethods.Embedded = "bottom"
+	require.ErrorContains(t, a.WithConfig(cfg).validateConfig(), `unknown embedded placement: "bottom"`)
+
+	cfg.InterfaceMethods.Embedded = config.EmbeddedLast
+	require.NoError(t, a.validateConfig())
+}
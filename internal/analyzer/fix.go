@@ -10,6 +10,8 @@ import (
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/log"
 )
 
 func (a *Analyzer) generateFix(pass *analysis.Pass, group []Metadata, elementType string) *FixSuggestion {
@@ -17,12 +19,22 @@ func (a *Analyzer) generateFix(pass *analysis.Pass, group []Metadata, elementTyp
 		return nil
 	}
 
+	if !a.cfg.FixSideEffects && hasSideEffects(pass, group) {
+		a.logger.Verbose("Skipping fix - values have side effects that would happen in another order", log.FieldPosition, pass.Fset.Position(group[0].Position))
+		return nil
+	}
+
 	sorted := make([]Metadata, len(group))
 	copy(sorted, group)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].Value < sorted[j].Value
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return lessMetadata(sorted[i], sorted[j])
 	})
 
+	if reordersNameUses(pass, sorted) {
+		a.logger.Verbose("Skipping fix - a local declaration would be used before it is declared or shadow an outer one", log.FieldPosition, pass.Fset.Position(group[0].Position))
+		return nil
+	}
+
 	var replacement []byte
 	var from, to token.Pos
 
@@ -33,7 +45,13 @@ func (a *Analyzer) generateFix(pass *analysis.Pass, group []Metadata, elementTyp
 		replacement, from, to = a.generateFieldFix(pass, group, sorted)
 	case *ast.KeyValueExpr:
 		replacement, from, to = a.generateKeyValueFix(pass, group, sorted)
-	case ast.Expr:
+	case unionTerm:
+		replacement, from, to = a.generateUnionFix(pass, group, sorted)
+	case testCase:
+		replacement, from, to = a.generateTestCaseFix(pass, group, sorted)
+	case switchCase:
+		replacement, from, to = a.generateCommentedFix(pass, group, sorted, "")
+	case ast.Expr, variadicPair:
 		replacement, from, to = a.generateExprFix(pass, group, sorted)
 	}
 
@@ -41,12 +59,16 @@ func (a *Analyzer) generateFix(pass *analysis.Pass, group []Metadata, elementTyp
 		return nil
 	}
 
-	return &FixSuggestion{
+	if !a.collecting(pass) {
+		replacement = a.withNestedFixes(pass, group, replacement, from, to)
+	}
+
+	return a.formatFix(pass, &FixSuggestion{
 		From:        from,
 		Message:     fmt.Sprintf("Sort %s", elementType),
 		Replacement: replacement,
 		To:          to,
-	}
+	})
 }
 
 func (a *Analyzer) generateGenDeclFix(pass *analysis.Pass, original, sorted []Metadata) ([]byte, token.Pos, token.Pos) {
@@ -54,10 +76,15 @@ func (a *Analyzer) generateGenDeclFix(pass *analysis.Pass, original, sorted []Me
 		return nil, 0, 0
 	}
 
+	// Specs on their own lines move together with their comments
+	if result, from, to := a.generateAttachedCommentsFix(pass, original, sorted, ""); result != nil {
+		return result, from, to
+	}
+
 	// Try to preserve original formatting by extracting with line context
 	if pass.ReadFile != nil {
-		if result := a.generateGenDeclFixPreserveFormat(pass, original, sorted); result != nil {
-			return result, original[0].Node.Pos(), original[len(original)-1].Node.End()
+		if result, from, to := a.generateGenDeclFixPreserveFormat(pass, original, sorted); result != nil {
+			return result, from, to
 		}
 	}
 
@@ -65,20 +92,21 @@ func (a *Analyzer) generateGenDeclFix(pass *analysis.Pass, original, sorted []Me
 	return a.generateGenDeclFixNodeByNode(pass, original, sorted)
 }
 
-func (a *Analyzer) generateGenDeclFixPreserveFormat(pass *analysis.Pass, original, sorted []Metadata) []byte {
+func (a *Analyzer) generateGenDeclFixPreserveFormat(pass *analysis.Pass, original, sorted []Metadata) ([]byte, token.Pos, token.Pos) {
 	if len(original) == 0 {
-		return nil
+		return nil, 0, 0
 	}
 
 	content, file := a.getFileContent(pass, original[0].Node.Pos())
-	if content == nil || file == nil {
-		return nil
+	if content == nil || file == nil || !a.onOwnLines(pass, original, file, content) {
+		return nil, 0, 0
 	}
 
-	declLines := a.extractDeclLines(original, file, content)
-	result := a.buildSortedResult(original, sorted, declLines)
+	declLines := a.extractDeclLines(pass, original, file, content)
+	result := a.buildSortedResult(pass, original, sorted, declLines)
+	from, to := a.linesSpan(pass, original, file, content)
 
-	return []byte(strings.Join(result, "\n"))
+	return []byte(strings.Join(result, "\n")), from, to
 }
 
 func (a *Analyzer) getFileContent(pass *analysis.Pass, pos token.Pos) ([]byte, *token.File) {
@@ -97,17 +125,17 @@ type declInfo struct {
 	meta     Metadata
 }
 
-func (a *Analyzer) extractDeclLines(original []Metadata, file *token.File, content []byte) map[*ast.ValueSpec]declInfo {
+func (a *Analyzer) extractDeclLines(pass *analysis.Pass, original []Metadata, file *token.File, content []byte) map[*ast.ValueSpec]declInfo {
 	declLines := make(map[*ast.ValueSpec]declInfo)
 
 	for _, meta := range original {
 		spec := meta.Node.(*ast.ValueSpec)
-		fullLine := a.extractFullLine(file, content, meta.Line)
+		fullLine := a.extractFullLines(pass, file, content, meta)
 
 		if fullLine != "" {
 			// Sort names within multi-name declarations
 			if len(spec.Names) > 1 {
-				fullLine = a.sortNamesInFullLine(fullLine, spec)
+				fullLine = a.sortNamesInFullLine(pass, fullLine, file.LineStart(meta.Line), spec)
 			}
 
 			declLines[spec] = declInfo{
@@ -120,9 +148,11 @@ func (a *Analyzer) extractDeclLines(original []Metadata, file *token.File, conte
 	return declLines
 }
 
-func (a *Analyzer) extractFullLine(file *token.File, content []byte, lineNum int) string {
-	lineStart := file.LineStart(lineNum)
-	lineEnd := a.findLineEnd(file, content, lineStart)
+// extractFullLines returns the lines of an element, from the start of its first line
+// to the end of the line it ends on, so elements spanning several lines are kept whole.
+func (a *Analyzer) extractFullLines(pass *analysis.Pass, file *token.File, content []byte, meta Metadata) string {
+	lineStart := file.LineStart(meta.Line)
+	lineEnd := a.findLineEnd(file, content, file.LineStart(endLine(pass, meta)))
 
 	startOffset := file.Offset(lineStart)
 	endOffset := file.Offset(lineEnd)
@@ -134,12 +164,17 @@ func (a *Analyzer) extractFullLine(file *token.File, content []byte, lineNum int
 	return ""
 }
 
-func (a *Analyzer) extractFieldLines(original []Metadata, file *token.File, content []byte) map[ast.Node]string {
+func (a *Analyzer) extractFieldLines(pass *analysis.Pass, original []Metadata, file *token.File, content []byte) map[ast.Node]string {
 	fieldLines := make(map[ast.Node]string)
 
 	for _, meta := range original {
-		fullLine := a.extractFullLine(file, content, meta.Line)
+		fullLine := a.extractFullLines(pass, file, content, meta)
 		if fullLine != "" {
+			// Sort names within multi-name fields
+			if field, ok := meta.Node.(*ast.Field); ok && len(field.Names) > 1 {
+				fullLine = a.sortNamesInFieldLines(pass, fullLine, file.LineStart(meta.Line), field)
+			}
+
 			fieldLines[meta.Node] = fullLine
 		}
 	}
@@ -147,12 +182,12 @@ func (a *Analyzer) extractFieldLines(original []Metadata, file *token.File, cont
 	return fieldLines
 }
 
-func (a *Analyzer) buildSortedFieldResult(original, sorted []Metadata, fieldLines map[ast.Node]string) []string {
+func (a *Analyzer) buildSortedFieldResult(pass *analysis.Pass, original, sorted []Metadata, fieldLines map[ast.Node]string) []string {
 	var result []string
 
 	for i, meta := range sorted {
 		if line, ok := fieldLines[meta.Node]; ok {
-			if i > 0 && !a.cfg.IgnoreGroups && a.shouldAddEmptyLine(original, sorted, i) {
+			if i > 0 && !a.cfg.IgnoreGroups && a.shouldAddEmptyLine(pass, original, sorted, i) {
 				result = append(result, "")
 			}
 			result = append(result, line)
@@ -174,13 +209,13 @@ func (a *Analyzer) findLineEnd(file *token.File, content []byte, lineStart token
 	return lineEnd
 }
 
-func (a *Analyzer) buildSortedResult(original, sorted []Metadata, declLines map[*ast.ValueSpec]declInfo) []string {
+func (a *Analyzer) buildSortedResult(pass *analysis.Pass, original, sorted []Metadata, declLines map[*ast.ValueSpec]declInfo) []string {
 	var result []string
 
 	for i, meta := range sorted {
 		spec := meta.Node.(*ast.ValueSpec)
 		if info, ok := declLines[spec]; ok {
-			if i > 0 && !a.cfg.IgnoreGroups && a.shouldAddEmptyLine(original, sorted, i) {
+			if i > 0 && !a.cfg.IgnoreGroups && a.shouldAddEmptyLine(pass, original, sorted, i) {
 				result = append(result, "")
 			}
 			result = append(result, info.fullLine)
@@ -190,12 +225,12 @@ func (a *Analyzer) buildSortedResult(original, sorted []Metadata, declLines map[
 	return result
 }
 
-func (a *Analyzer) shouldAddEmptyLine(original, sorted []Metadata, currentIdx int) bool {
+func (a *Analyzer) shouldAddEmptyLine(pass *analysis.Pass, original, sorted []Metadata, currentIdx int) bool {
 	prevIdx := a.findOriginalIndex(original, sorted[currentIdx-1].Node)
 	currIdx := a.findOriginalIndex(original, sorted[currentIdx].Node)
 
 	if prevIdx >= 0 && currIdx >= 0 && prevIdx < len(original)-1 {
-		return original[prevIdx+1].Line-original[prevIdx].Line > 1
+		return hasEmptyLineBetween(pass, original[prevIdx], original[prevIdx+1])
 	}
 
 	return false
@@ -222,7 +257,7 @@ func (a *Analyzer) generateGenDeclFixNodeByNode(pass *analysis.Pass, original, s
 
 		if i > 0 {
 			buf.WriteByte('\n')
-			if !a.cfg.IgnoreGroups && original[i].Line-original[i-1].Line > 1 {
+			if !a.cfg.IgnoreGroups && hasEmptyLineBetween(pass, original[i-1], original[i]) {
 				buf.WriteByte('\n')
 			}
 		}
@@ -230,7 +265,7 @@ func (a *Analyzer) generateGenDeclFixNodeByNode(pass *analysis.Pass, original, s
 		srcText := sourceMap[spec]
 
 		if len(spec.Names) > 1 {
-			srcText = a.sortNamesInDecl(srcText, spec)
+			srcText = a.sortNamesInDecl(pass, srcText, spec)
 		}
 
 		buf.WriteString(srcText)
@@ -244,10 +279,15 @@ func (a *Analyzer) generateFieldFix(pass *analysis.Pass, original, sorted []Meta
 		return nil, 0, 0
 	}
 
+	// Fields on their own lines move together with their comments
+	if result, from, to := a.generateAttachedCommentsFix(pass, original, sorted, ""); result != nil {
+		return result, from, to
+	}
+
 	// Try to preserve original formatting by extracting with line context
 	if pass.ReadFile != nil {
-		if result := a.generateFieldFixPreserveFormat(pass, original, sorted); result != nil {
-			return result, original[0].Node.Pos(), original[len(original)-1].Node.End()
+		if result, from, to := a.generateFieldFixPreserveFormat(pass, original, sorted); result != nil {
+			return result, from, to
 		}
 	}
 
@@ -255,20 +295,60 @@ func (a *Analyzer) generateFieldFix(pass *analysis.Pass, original, sorted []Meta
 	return a.generateIndentedFix(pass, original, sorted, "", "\n")
 }
 
-func (a *Analyzer) generateFieldFixPreserveFormat(pass *analysis.Pass, original, sorted []Metadata) []byte {
+func (a *Analyzer) generateFieldFixPreserveFormat(pass *analysis.Pass, original, sorted []Metadata) ([]byte, token.Pos, token.Pos) {
 	if len(original) == 0 {
-		return nil
+		return nil, 0, 0
 	}
 
 	content, file := a.getFileContent(pass, original[0].Node.Pos())
-	if content == nil || file == nil {
-		return nil
+	if content == nil || file == nil || !a.onOwnLines(pass, original, file, content) {
+		return nil, 0, 0
 	}
 
-	fieldLines := a.extractFieldLines(original, file, content)
-	result := a.buildSortedFieldResult(original, sorted, fieldLines)
+	fieldLines := a.extractFieldLines(pass, original, file, content)
+	result := a.buildSortedFieldResult(pass, original, sorted, fieldLines)
+	from, to := a.linesSpan(pass, original, file, content)
 
-	return []byte(strings.Join(result, "\n"))
+	return []byte(strings.Join(result, "\n")), from, to
+}
+
+// onOwnLines reports whether every element of the group occupies whole lines,
+// optionally followed by a comment, with nothing but empty lines between them, so
+// the group can be reordered line by line.
+func (a *Analyzer) onOwnLines(pass *analysis.Pass, group []Metadata, file *token.File, content []byte) bool {
+	for i, meta := range group {
+		if i > 0 && meta.Line <= endLine(pass, group[i-1]) {
+			return false
+		}
+
+		// Lines between elements, like comments, would be lost
+		lineStart := file.Offset(file.LineStart(meta.Line))
+		if i > 0 {
+			prevEnd := file.Offset(a.findLineEnd(file, content, file.LineStart(endLine(pass, group[i-1]))))
+			if strings.TrimSpace(string(content[prevEnd:lineStart])) != "" {
+				return false
+			}
+		}
+
+		if strings.TrimSpace(string(content[lineStart:file.Offset(meta.Node.Pos())])) != "" {
+			return false
+		}
+
+		lineEnd := file.Offset(a.findLineEnd(file, content, file.LineStart(endLine(pass, meta))))
+		rest := strings.TrimSpace(string(content[file.Offset(meta.Node.End()):lineEnd]))
+		if rest != "" && !strings.HasPrefix(rest, "//") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// linesSpan returns the range of the lines the group occupies, from the start of
+// the first line to the end of the last one.
+func (a *Analyzer) linesSpan(pass *analysis.Pass, group []Metadata, file *token.File, content []byte) (token.Pos, token.Pos) {
+	last := group[len(group)-1]
+	return file.LineStart(group[0].Line), a.findLineEnd(file, content, file.LineStart(endLine(pass, last)))
 }
 
 func (a *Analyzer) generateExprFix(pass *analysis.Pass, original, sorted []Metadata) ([]byte, token.Pos, token.Pos) {
@@ -276,23 +356,137 @@ func (a *Analyzer) generateExprFix(pass *analysis.Pass, original, sorted []Metad
 		return nil, 0, 0
 	}
 
+	// Elements on their own lines move together with their comments
+	if result, from, to := a.generateAttachedCommentsFix(pass, original, sorted, ","); result != nil {
+		return result, from, to
+	}
+
+	from := original[0].Node.Pos()
+	to := original[len(original)-1].Node.End()
+
+	if hasCommentsWithin(pass, from, to) {
+		a.logger.Verbose("Skipping fix - comments between elements sharing lines would be lost", log.FieldPosition, pass.Fset.Position(from))
+		return nil, 0, 0
+	}
+
+	// Try to preserve multi-line layout of list elements
+	if result := a.generateListFixPreserveFormat(pass, original, sorted); result != nil {
+		return result, from, to
+	}
+
 	sourceMap := a.buildSourceMap(pass, original)
 
 	var buf bytes.Buffer
+	for i, meta := range sorted {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		buf.WriteString(sourceMap[meta.Node])
+	}
+
+	return buf.Bytes(), from, to
+}
+
+// generateUnionFix reorders the terms of a union, keeping the separators between
+// them, and the comments they contain, in place so that unions spanning several
+// lines keep their layout. In those, a comment at the end of a term's line moves
+// together with the term.
+func (a *Analyzer) generateUnionFix(pass *analysis.Pass, original, sorted []Metadata) ([]byte, token.Pos, token.Pos) {
+	if len(original) == 0 {
+		return nil, 0, 0
+	}
+
 	from := original[0].Node.Pos()
 	to := original[len(original)-1].Node.End()
 
+	astFile := fileOf(pass, from)
+	if pass.ReadFile == nil || astFile == nil {
+		return a.generateUnionFixJoined(pass, sorted), from, to
+	}
+
+	content, file := a.getFileContent(pass, from)
+	if content == nil || file == nil {
+		return a.generateUnionFixJoined(pass, sorted), from, to
+	}
+
+	source := func(from, to token.Pos) string {
+		return string(content[file.Offset(from):file.Offset(to)])
+	}
+
+	multiLine := file.Line(from) != file.Line(to)
+	separators := make([]string, len(original)-1)
+	comments := make(map[ast.Node]string)
+	for i, meta := range original {
+		term := meta.Node
+		next := token.NoPos
+		if i < len(original)-1 {
+			next = original[i+1].Node.Pos()
+			separators[i] = source(term.End(), next)
+		}
+
+		for _, group := range astFile.Comments {
+			if !multiLine {
+				break
+			}
+
+			if group.Pos() < term.End() || next.IsValid() && group.End() > next || file.Line(group.Pos()) != file.Line(term.End()) {
+				continue
+			}
+
+			comments[term] = source(group.Pos(), group.End())
+			if next.IsValid() {
+				cut := int(group.Pos() - term.End())
+				separators[i] = strings.TrimRight(separators[i][:cut], " \t") + separators[i][cut+int(group.End()-group.Pos()):]
+			} else {
+				to = group.End()
+			}
+
+			break
+		}
+	}
+
+	var buf strings.Builder
 	for i, meta := range sorted {
-		expr := meta.Node.(ast.Expr)
+		buf.WriteString(source(meta.Node.Pos(), meta.Node.End()))
+
+		comment := comments[meta.Node]
+		if i == len(sorted)-1 {
+			if comment != "" {
+				buf.WriteString(" " + comment)
+			}
+			break
+		}
+
+		separator := separators[i]
+		if comment != "" {
+			lineEnd := strings.IndexByte(separator, '\n')
+			if lineEnd < 0 {
+				a.logger.Verbose("Skipping fix - a comment of a union term would be followed by another term", log.FieldPosition, pass.Fset.Position(from))
+				return nil, 0, 0
+			}
+			separator = separator[:lineEnd] + " " + comment + separator[lineEnd:]
+		}
+
+		buf.WriteString(separator)
+	}
+
+	return []byte(buf.String()), from, to
+}
 
+// generateUnionFixJoined joins the sorted terms on a single line, for when the
+// source of the union is not available.
+func (a *Analyzer) generateUnionFixJoined(pass *analysis.Pass, sorted []Metadata) []byte {
+	var buf bytes.Buffer
+	for i, meta := range sorted {
 		if i > 0 {
-			buf.WriteString(", ")
+			buf.WriteString(" | ")
 		}
 
-		buf.WriteString(sourceMap[expr])
+		buf.WriteString(a.extractNodeSource(pass, meta.Node.(unionTerm).Expr))
 	}
 
-	return buf.Bytes(), from, to
+	return buf.Bytes()
 }
 
 func (a *Analyzer) generateKeyValueFix(pass *analysis.Pass, original, sorted []Metadata) ([]byte, token.Pos, token.Pos) {
@@ -300,9 +494,19 @@ func (a *Analyzer) generateKeyValueFix(pass *analysis.Pass, original, sorted []M
 		return nil, 0, 0
 	}
 
+	// Elements on their own lines move together with their comments
+	if result, from, to := a.generateAttachedCommentsFix(pass, original, sorted, ","); result != nil {
+		return result, from, to
+	}
+
+	if hasCommentsWithin(pass, original[0].Node.Pos(), original[len(original)-1].Node.End()) {
+		a.logger.Verbose("Skipping fix - comments between elements sharing lines would be lost", log.FieldPosition, pass.Fset.Position(original[0].Position))
+		return nil, 0, 0
+	}
+
 	// Try to preserve original formatting by extracting with line context
 	if pass.ReadFile != nil {
-		if result := a.generateKeyValueFixPreserveFormat(pass, original, sorted); result != nil {
+		if result := a.generateListFixPreserveFormat(pass, original, sorted); result != nil {
 			return result, original[0].Node.Pos(), original[len(original)-1].Node.End()
 		}
 	}
@@ -327,7 +531,9 @@ func (a *Analyzer) generateKeyValueFix(pass *analysis.Pass, original, sorted []M
 	return buf.Bytes(), from, to
 }
 
-func (a *Analyzer) generateKeyValueFixPreserveFormat(pass *analysis.Pass, original, sorted []Metadata) []byte {
+// generateListFixPreserveFormat reorders comma-separated list elements (composite
+// literal elements, call arguments) placed on separate lines, keeping one element per line.
+func (a *Analyzer) generateListFixPreserveFormat(pass *analysis.Pass, original, sorted []Metadata) []byte {
 	if len(original) == 0 {
 		return nil
 	}
@@ -351,22 +557,22 @@ func (a *Analyzer) generateKeyValueFixPreserveFormat(pass *analysis.Pass, origin
 	// Build multi-line format preserving indentation
 	sourceMap := a.buildSourceMap(pass, original)
 	var buf bytes.Buffer
-	
+
 	// Detect common indentation
 	indentLevel := a.detectKeyValueIndent(pass, original)
-	
+
 	for i, meta := range sorted {
-		kv := meta.Node.(*ast.KeyValueExpr)
-		
 		if i > 0 {
 			buf.WriteString(",\n")
-			if !a.cfg.IgnoreGroups && a.shouldAddEmptyLine(original, sorted, i) {
+			if !a.cfg.IgnoreGroups && a.shouldAddEmptyLine(pass, original, sorted, i) {
 				buf.WriteByte('\n')
 			}
+
+			// The first element is preceded by the original indentation already
+			buf.WriteString(indentLevel)
 		}
-		
-		buf.WriteString(indentLevel)
-		buf.WriteString(sourceMap[kv])
+
+		buf.WriteString(sourceMap[meta.Node])
 	}
 
 	return buf.Bytes()
@@ -388,7 +594,7 @@ func (a *Analyzer) generateIndentedFix(pass *analysis.Pass, original, sorted []M
 	for i, meta := range sorted {
 		if i > 0 {
 			buf.WriteString(prefix + separator)
-			if !a.cfg.IgnoreGroups && original[i].Line-original[i-1].Line > 1 {
+			if !a.cfg.IgnoreGroups && hasEmptyLineBetween(pass, original[i-1], original[i]) {
 				buf.WriteByte('\n')
 			}
 		}
@@ -451,6 +657,17 @@ func (a *Analyzer) extractFromFile(pass *analysis.Pass, node ast.Node) string {
 }
 
 func (a *Analyzer) formatNode(pass *analysis.Pass, node ast.Node) string {
+	if pair, ok := node.(variadicPair); ok {
+		if pair.Value == nil {
+			return a.formatNode(pass, pair.Key)
+		}
+		return a.formatNode(pass, pair.Key) + ", " + a.formatNode(pass, pair.Value)
+	}
+
+	if c, ok := node.(testCase); ok {
+		node = c.Expr
+	}
+
 	var buf bytes.Buffer
 	if err := format.Node(&buf, pass.Fset, node); err != nil {
 		return ""
@@ -458,38 +675,37 @@ func (a *Analyzer) formatNode(pass *analysis.Pass, node ast.Node) string {
 	return buf.String()
 }
 
-func (a *Analyzer) sortNamesInDecl(srcText string, spec *ast.ValueSpec) string {
-	if len(spec.Names) <= 1 {
+func (a *Analyzer) sortNamesInDecl(pass *analysis.Pass, srcText string, spec *ast.ValueSpec) string {
+	if len(elementNames(spec)) <= 1 {
 		return srcText
 	}
 
-	names := make([]string, len(spec.Names))
-	for i, name := range spec.Names {
-		names[i] = name.Name
-	}
-
-	originalNames := strings.Join(names, ", ")
-	sort.Strings(names)
-	sortedNames := strings.Join(names, ", ")
-
-	return strings.Replace(srcText, originalNames, sortedNames, 1)
+	return a.sortedSpec(pass, spec)
 }
 
-func (a *Analyzer) sortNamesInFullLine(line string, spec *ast.ValueSpec) string {
-	if len(spec.Names) <= 1 {
+// sortNamesInFullLine sorts the names of a spec written on the line starting at
+// lineStart. Specs continuing on the next lines are left as is.
+func (a *Analyzer) sortNamesInFullLine(pass *analysis.Pass, line string, lineStart token.Pos, spec *ast.ValueSpec) string {
+	if len(elementNames(spec)) <= 1 {
 		return line
 	}
 
-	names := make([]string, len(spec.Names))
-	for i, name := range spec.Names {
-		names[i] = name.Name
+	from, to := int(spec.Pos()-lineStart), int(spec.End()-lineStart)
+	if from < 0 || to > len(line) {
+		return line
 	}
 
-	originalNames := strings.Join(names, ", ")
-	sort.Strings(names)
-	sortedNames := strings.Join(names, ", ")
+	return line[:from] + a.sortedSpec(pass, spec) + line[to:]
+}
+
+// sortNamesInFieldLines sorts the names of a multi-name field within the lines it spans.
+func (a *Analyzer) sortNamesInFieldLines(pass *analysis.Pass, lines string, lineStart token.Pos, field *ast.Field) string {
+	from, to := int(field.Names[0].Pos()-lineStart), int(field.Names[len(field.Names)-1].End()-lineStart)
+	if from < 0 || to > len(lines) {
+		return lines
+	}
 
-	return strings.Replace(line, originalNames, sortedNames, 1)
+	return lines[:from] + a.sortedNameList(pass, field.Names) + lines[to:]
 }
 
 func (a *Analyzer) detectKeyValueIndent(pass *analysis.Pass, original []Metadata) string {
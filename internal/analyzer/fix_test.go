@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"golang.org/x/tools/go/analysis/analysistest"
+
+	"go.tomakado.io/sortir/internal/config"
 )
 
 func TestAnalyzerFixes(t *testing.T) {
@@ -49,6 +51,13 @@ func TestAnalyzerFixes(t *testing.T) {
 			dir:  "fix/map_keys",
 			name: "map_keys",
 		},
+		{
+			analyzer: func() *Analyzer {
+				return New()
+			},
+			dir:  "fix/numeric_keys",
+			name: "numeric_keys",
+		},
 		{
 			analyzer: func() *Analyzer {
 				a := New()
@@ -60,11 +69,76 @@ func TestAnalyzerFixes(t *testing.T) {
 		},
 		{
 			analyzer: func() *Analyzer {
-				return New()
+				a := New()
+				a.cfg.VariadicArgs.Enabled = true
+				return a
+			},
+			dir:  "fix/variadic_pairs",
+			name: "variadic_pairs",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.VariadicArgs.Enabled = true
+				a.cfg.VariadicArgs.Options = config.Patterns{"*.New"}
+				return a
+			},
+			dir:  "fix/variadic_options",
+			name: "variadic_options",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.FixSideEffects = true
+				return a
 			},
 			dir:  "fix/multiname",
 			name: "multiname_declarations",
 		},
+		{
+			analyzer: func() *Analyzer {
+				return New()
+			},
+			dir:  "fix/side_effects",
+			name: "side_effects",
+		},
+		{
+			analyzer: func() *Analyzer {
+				return New()
+			},
+			dir:  "fix/multiname_fields",
+			name: "multiname_fields",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.StructFields.Embedded = config.EmbeddedFirst
+				return a
+			},
+			dir:  "fix/embedded",
+			name: "embedded",
+		},
+		{
+			analyzer: func() *Analyzer {
+				return New()
+			},
+			dir:  "fix/anchors",
+			name: "anchors",
+		},
+		{
+			analyzer: func() *Analyzer {
+				return New()
+			},
+			dir:  "fix/comments",
+			name: "comments",
+		},
+		{
+			analyzer: func() *Analyzer {
+				return New()
+			},
+			dir:  "fix/multiline",
+			name: "multiline",
+		},
 		{
 			analyzer: func() *Analyzer {
 				return New()
@@ -95,6 +169,85 @@ func TestAnalyzerFixes(t *testing.T) {
 			dir:  "fix/ignore_groups",
 			name: "ignore_groups",
 		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.SliceElements.Types = config.Patterns{"*.Kind"}
+				a.cfg.SliceElements.Variables = config.Patterns{"allowed*"}
+				return a
+			},
+			dir:  "fix/slice_elements",
+			name: "slice_elements",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.StructFields.Enabled = false
+				a.cfg.StructTags.Enabled = true
+				a.cfg.StructTags.Order = config.List{"json", "yaml", "db", "validate"}
+				return a
+			},
+			dir:  "fix/struct_tags",
+			name: "struct_tags",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.StructTags.Enabled = true
+				return a
+			},
+			dir:  "fix/tagged_fields",
+			name: "tagged_fields",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.Imports.Enabled = true
+				a.cfg.Imports.Module = "imports"
+				a.cfg.Imports.Sections = config.List{"std", "third-party", "prefix(github.com/acme)", "module"}
+				return a
+			},
+			dir:  "fix/imports/app",
+			name: "imports",
+		},
+		{
+			analyzer: func() *Analyzer {
+				return New()
+			},
+			dir:  "fix/union_terms",
+			name: "union_terms",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.TestCases.Enabled = true
+				return a
+			},
+			dir:  "fix/test_cases",
+			name: "test_cases",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.StructFields.Enabled = false
+				a.cfg.StructLiterals.Order = config.OrderDeclaration
+				return a
+			},
+			dir:  "fix/declaration_order",
+			name: "declaration_order",
+		},
+		{
+			analyzer: func() *Analyzer {
+				a := New()
+				a.cfg.MapLiterals.Order = config.OrderDeclaration
+				a.cfg.SwitchCases.Enabled = true
+				a.cfg.SwitchCases.Order = config.OrderDeclaration
+				a.setRequires()
+				return a
+			},
+			dir:  "fix/enum_order",
+			name: "enum_order",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,211 @@
+package analyzer
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// formatFix re-formats the node enclosing the fix the way gofmt does, so columns
+// aligned across the sorted elements, like types, values and trailing comments,
+// stay aligned once the fix is applied. Only the lines of the fix that change are
+// replaced.
+// The fix is returned as is if the node cannot be formatted.
+func (a *Analyzer) formatFix(pass *analysis.Pass, fix *FixSuggestion) *FixSuggestion {
+	if pass.ReadFile == nil {
+		return fix
+	}
+
+	content, file := a.getFileContent(pass, fix.From)
+	astFile := fileOf(pass, fix.From)
+	if content == nil || file == nil || astFile == nil {
+		return fix
+	}
+
+	node := enclosingNode(astFile, fix.From, fix.To)
+	if node == nil || hasMultiLineRawString(node) {
+		return fix
+	}
+
+	// Only the node is parsed again, wrapped into a file of its own
+	var prefix string
+	switch node.(type) {
+	case *ast.GenDecl:
+		prefix = "package p\n"
+	case *ast.SwitchStmt:
+		prefix = "package p\nfunc _() {\n"
+	case *ast.StructType, *ast.InterfaceType:
+		prefix = "package p\ntype _ "
+	default:
+		prefix = "package p\nvar _ = "
+	}
+
+	start, end := file.Offset(node.Pos()), file.Offset(node.End())
+	src := prefix + string(content[start:file.Offset(fix.From)]) + string(fix.Replacement) + string(content[file.Offset(fix.To):end])
+	if _, ok := node.(*ast.SwitchStmt); ok {
+		src += "\n}"
+	}
+
+	formatted, ok := formatNodeAt(src, len(prefix), reflect.TypeOf(node))
+	if !ok {
+		return fix
+	}
+
+	// Lines after the first one are printed without the indentation of the node
+	lineStart := file.Offset(file.LineStart(file.Line(node.Pos())))
+	indent := string(content[lineStart:start])
+	indent = indent[:len(indent)-len(strings.TrimLeft(indent, " \t"))]
+
+	lines := strings.Split(formatted, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = indent + lines[i]
+		}
+	}
+
+	// Only the lines of the group are replaced. When the source is not gofmt'd, lines
+	// of other groups change too, and the fixes of those groups replace them.
+	before := string(content[start:file.Offset(fix.From)])
+	after := string(content[file.Offset(fix.To):end])
+	if strings.Count(before+string(fix.Replacement)+after, "\n") != len(lines)-1 {
+		return fix
+	}
+
+	first := strings.Count(before, "\n")
+	last := first + strings.Count(string(fix.Replacement), "\n")
+	original := strings.Split(string(content[start:end]), "\n")
+	originalLast := strings.Count(string(content[start:file.Offset(fix.To)]), "\n")
+
+	offset := 0
+	for _, line := range original[:first] {
+		offset += len(line) + 1
+	}
+
+	from, to, replacement, ok := changedLines(strings.Join(original[first:originalLast+1], "\n"), lines[first:last+1])
+	if !ok {
+		return fix
+	}
+
+	return &FixSuggestion{
+		From:        node.Pos() + token.Pos(offset+from),
+		Message:     fix.Message,
+		Replacement: []byte(replacement),
+		To:          node.Pos() + token.Pos(offset+to),
+	}
+}
+
+// enclosingNode returns the innermost declaration, type, literal, call or switch
+// statement containing the range.
+func enclosingNode(file *ast.File, from, to token.Pos) ast.Node {
+	path, _ := astutil.PathEnclosingInterval(file, from, to)
+	for _, node := range path {
+		switch node.(type) {
+		case *ast.GenDecl, *ast.StructType, *ast.InterfaceType, *ast.CompositeLit, *ast.CallExpr, *ast.SwitchStmt:
+			return node
+		}
+	}
+
+	return nil
+}
+
+// hasMultiLineRawString reports whether the node contains a raw string spanning
+// several lines, whose content must not be indented.
+func hasMultiLineRawString(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING && strings.HasPrefix(lit.Value, "`") && strings.Contains(lit.Value, "\n") {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// formatNodeAt parses the source and formats the node of the given type starting at
+// the offset, together with the comments inside it.
+func formatNodeAt(src string, offset int, nodeType reflect.Type) (string, bool) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return "", false
+	}
+
+	var node ast.Node
+	ast.Inspect(file, func(n ast.Node) bool {
+		if node != nil || n == nil {
+			return false
+		}
+
+		if reflect.TypeOf(n) == nodeType && fset.Position(n.Pos()).Offset == offset {
+			node = n
+			return false
+		}
+
+		return true
+	})
+
+	if node == nil {
+		return "", false
+	}
+
+	var comments []*ast.CommentGroup
+	for _, group := range file.Comments {
+		if group.Pos() >= node.Pos() && group.End() <= node.End() {
+			comments = append(comments, group)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, &printer.CommentedNode{Comments: comments, Node: node}); err != nil {
+		return "", false
+	}
+
+	return buf.String(), true
+}
+
+// changedLines compares original source lines with their formatted version and
+// returns the range of the original source to replace, relative to its start, and
+// the lines replacing it. Unchanged lines around are left out.
+func changedLines(original string, formatted []string) (int, int, string, bool) {
+	lines := strings.Split(original, "\n")
+
+	prefix := 0
+	for prefix < len(lines) && prefix < len(formatted) && lines[prefix] == formatted[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(lines)-prefix && suffix < len(formatted)-prefix && lines[len(lines)-1-suffix] == formatted[len(formatted)-1-suffix] {
+		suffix++
+	}
+
+	// Nothing changed, or lines were only added or removed
+	if prefix == len(lines)-suffix || prefix == len(formatted)-suffix {
+		return 0, 0, "", false
+	}
+
+	from := 0
+	for _, line := range lines[:prefix] {
+		from += len(line) + 1
+	}
+
+	to := from
+	for i, line := range lines[prefix : len(lines)-suffix] {
+		if i > 0 {
+			to++
+		}
+		to += len(line)
+	}
+
+	return from, to, strings.Join(formatted[prefix:len(formatted)-suffix], "\n"), true
+}
@@ -0,0 +1,73 @@
+package analyzer
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestFormatNodeAt(t *testing.T) {
+	src := "package p\nvar (\n\tlongest int // a\n\tb string // b\n)"
+
+	formatted, ok := formatNodeAt(src, len("package p\n"), reflect.TypeOf(&ast.GenDecl{}))
+	require.True(t, ok)
+	require.Equal(t, "var (\n\tlongest int    // a\n\tb       string // b\n)", formatted)
+}
+
+func TestChangedLines(t *testing.T) {
+	original := "var (\n\ta int\n\tbb int\n)"
+
+	from, to, replacement, ok := changedLines(original, strings.Split("var (\n\ta  int\n\tbb int\n)", "\n"))
+	require.True(t, ok)
+	require.Equal(t, "\ta int", original[from:to])
+	require.Equal(t, "\ta  int", replacement)
+
+	_, _, _, ok = changedLines(original, strings.Split(original, "\n"))
+	require.False(t, ok)
+}
+
+// TestFixAlignment applies all fixes to source that is not gofmt'd and compares the
+// result as is: analysistest formats both the result and the golden file, which
+// hides broken alignment.
+func TestFixAlignment(t *testing.T) {
+	dir := filepath.Join(analysistest.TestData(), "src", "alignment")
+	results := analysistest.Run(t, analysistest.TestData(), New().Analyzer(), "alignment")
+	require.Len(t, results, 1)
+
+	var edits []analysis.TextEdit
+	for _, diagnostic := range results[0].Diagnostics {
+		for _, fix := range diagnostic.SuggestedFixes {
+			edits = append(edits, fix.TextEdits...)
+		}
+	}
+	require.NotEmpty(t, edits)
+	slices.SortFunc(edits, func(x, y analysis.TextEdit) int { return int(x.Pos - y.Pos) })
+
+	content, err := os.ReadFile(filepath.Join(dir, "alignment.go"))
+	require.NoError(t, err)
+
+	file := results[0].Pass.Fset.File(edits[0].Pos)
+	var fixed []byte
+	last := 0
+	for _, edit := range edits {
+		start, end := file.Offset(edit.Pos), file.Offset(edit.End)
+		require.GreaterOrEqual(t, start, last, "fixes overlap")
+
+		fixed = append(fixed, content[last:start]...)
+		fixed = append(fixed, edit.NewText...)
+		last = end
+	}
+	fixed = append(fixed, content[last:]...)
+
+	golden, err := os.ReadFile(filepath.Join(dir, "alignment.go.golden"))
+	require.NoError(t, err)
+	require.Equal(t, string(golden), string(fixed))
+}
@@ -0,0 +1,219 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// hasPositionalValues reports whether the values of a const block depend on the
+// position of its specs: a spec uses iota, or has no values and repeats the
+// expression of the previous one. Reordering such a block changes the constants.
+func hasPositionalValues(pass *analysis.Pass, specs []*ast.ValueSpec) bool {
+	for _, spec := range specs {
+		if len(spec.Values) == 0 {
+			return true
+		}
+
+		for _, value := range spec.Values {
+			if usesIota(pass, value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// usesIota reports whether the expression refers to the predeclared iota.
+func usesIota(pass *analysis.Pass, expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(node ast.Node) bool {
+		ident, ok := node.(*ast.Ident)
+		if !ok || ident.Name != "iota" {
+			return !found
+		}
+
+		if pass.TypesInfo == nil {
+			found = true
+		} else if obj, ok := pass.TypesInfo.Uses[ident]; ok {
+			found = obj == types.Universe.Lookup("iota")
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// hasSideEffects reports whether evaluating the values of the group has side
+// effects. Values of var specs, composite literal elements and test cases are
+// evaluated in source order, so sorting them changes when the effects happen.
+func hasSideEffects(pass *analysis.Pass, group []Metadata) bool {
+	for _, meta := range group {
+		var exprs []ast.Expr
+		switch n := meta.Node.(type) {
+		case *ast.ValueSpec:
+			exprs = n.Values
+		case *ast.KeyValueExpr:
+			exprs = []ast.Expr{n.Key, n.Value}
+		case testCase:
+			exprs = []ast.Expr{n.Expr}
+		}
+
+		for _, expr := range exprs {
+			if isEffectful(pass, expr) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// pureBuiltins are the builtin functions without side effects.
+var pureBuiltins = map[string]bool{
+	"append": true, "cap": true, "complex": true, "imag": true, "len": true,
+	"make": true, "max": true, "min": true, "new": true, "real": true,
+}
+
+// isEffectful reports whether evaluating the expression may have side effects:
+// it calls a function or receives from a channel. Function literals are only
+// evaluated when called, so their bodies are not looked into. Constants, literals,
+// identifiers, conversions and pure builtins are free of side effects.
+func isEffectful(pass *analysis.Pass, expr ast.Expr) bool {
+	effectful := false
+	ast.Inspect(expr, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				effectful = true
+			}
+		case *ast.CallExpr:
+			if !isPureCall(pass, n) {
+				effectful = true
+			}
+		}
+
+		return !effectful
+	})
+
+	return effectful
+}
+
+// isPureCall reports whether the call is a conversion or a call of a pure builtin.
+func isPureCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	if pass.TypesInfo == nil {
+		return false
+	}
+
+	if tv, ok := pass.TypesInfo.Types[call.Fun]; ok && tv.IsType() {
+		return true
+	}
+
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	builtin, ok := pass.TypesInfo.Uses[ident].(*types.Builtin)
+	return ok && pureBuiltins[builtin.Name()]
+}
+
+// reordersNameUses reports whether sorting a group of local value specs moves a
+// spec across another one declaring a name it uses. Unlike package-level
+// declarations, which are initialized by dependency, local ones are only in scope
+// after their declaration: the name would either be used before it is declared or,
+// when it referred to an outer declaration, be shadowed by the local one.
+func reordersNameUses(pass *analysis.Pass, sorted []Metadata) bool {
+	if pass.TypesInfo == nil {
+		return false
+	}
+
+	declaredAt := make(map[types.Object]int)
+	byName := make(map[string]types.Object)
+	for i, meta := range sorted {
+		spec, ok := meta.Node.(*ast.ValueSpec)
+		if !ok {
+			return false
+		}
+
+		for _, name := range spec.Names {
+			obj := pass.TypesInfo.Defs[name]
+			if obj == nil || obj.Parent() == pass.Pkg.Scope() {
+				continue
+			}
+			declaredAt[obj] = i
+			byName[name.Name] = obj
+		}
+	}
+
+	if len(declaredAt) == 0 {
+		return false
+	}
+
+	for i, meta := range sorted {
+		reordered := false
+
+		var visit func(node ast.Node) bool
+		visit = func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.SelectorExpr:
+				// Field and method names do not refer to the declared names
+				ast.Inspect(n.X, visit)
+				return false
+			case *ast.Ident:
+				obj := pass.TypesInfo.Uses[n]
+				if obj == nil {
+					break
+				}
+
+				// A name declared in the group, or an outer one with the same name that
+				// the local declaration would shadow
+				at, ok := declaredAt[obj]
+				if !ok {
+					local, shadows := byName[n.Name]
+					if !shadows || !encloses(obj.Parent(), local.Parent()) {
+						break
+					}
+					at = declaredAt[local]
+				}
+
+				// The declaring spec goes before the using one after sorting but not in
+				// source, or the other way round
+				if at != i && (at < i) != (sorted[at].Position < meta.Position) {
+					reordered = true
+				}
+			}
+
+			return !reordered
+		}
+		ast.Inspect(meta.Node, visit)
+
+		if reordered {
+			return true
+		}
+	}
+
+	return false
+}
+
+// encloses reports whether the outer scope is the inner one or one of its parents.
+// Fields and methods have no scope and enclose nothing.
+func encloses(outer, inner *types.Scope) bool {
+	if outer == nil {
+		return false
+	}
+
+	for scope := inner; scope != nil; scope = scope.Parent() {
+		if scope == outer {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,390 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/config"
+	"go.tomakado.io/sortir/internal/log"
+)
+
+type importSectionKind int
+
+const (
+	importSectionStd importSectionKind = iota
+	importSectionThirdParty
+	importSectionModule
+	importSectionPrefix
+)
+
+// importSection is a parsed section specifier from config.
+type importSection struct {
+	kind   importSectionKind
+	name   string
+	prefix string
+}
+
+// parseImportSections parses section specifiers like "std", "third-party", "module"
+// and "prefix(github.com/org)". Unknown specifiers are returned as an error.
+func parseImportSections(specs []string) ([]importSection, error) {
+	sections := make([]importSection, 0, len(specs))
+	for _, spec := range specs {
+		switch {
+		case spec == config.ImportSectionStd:
+			sections = append(sections, importSection{kind: importSectionStd, name: spec})
+		case spec == config.ImportSectionThirdParty:
+			sections = append(sections, importSection{kind: importSectionThirdParty, name: spec})
+		case spec == config.ImportSectionModule:
+			sections = append(sections, importSection{kind: importSectionModule, name: spec})
+		case strings.HasPrefix(spec, config.ImportSectionPrefix+"(") && strings.HasSuffix(spec, ")"):
+			prefix := strings.TrimSuffix(strings.TrimPrefix(spec, config.ImportSectionPrefix+"("), ")")
+			if prefix == "" {
+				return nil, fmt.Errorf("empty import section prefix: %q", spec)
+			}
+			sections = append(sections, importSection{kind: importSectionPrefix, name: spec, prefix: prefix})
+		default:
+			return nil, fmt.Errorf("unknown import section: %q", spec)
+		}
+	}
+
+	return sections, nil
+}
+
+// sectionIndex returns the index of the section the import path belongs to.
+// Custom prefixes take precedence over the current module, which takes precedence over
+// the standard library. Paths matching no configured section go to the third-party
+// section, or after all sections if there is none.
+func sectionIndex(sections []importSection, modulePath, importPath string) int {
+	longestPrefix, prefixIdx := 0, -1
+	moduleIdx, stdIdx, thirdPartyIdx := -1, -1, -1
+
+	for i, section := range sections {
+		switch section.kind {
+		case importSectionPrefix:
+			if hasPathPrefix(importPath, section.prefix) && len(section.prefix) > longestPrefix {
+				longestPrefix, prefixIdx = len(section.prefix), i
+			}
+		case importSectionModule:
+			moduleIdx = i
+		case importSectionStd:
+			stdIdx = i
+		case importSectionThirdParty:
+			thirdPartyIdx = i
+		}
+	}
+
+	switch {
+	case prefixIdx >= 0:
+		return prefixIdx
+	case moduleIdx >= 0 && modulePath != "" && hasPathPrefix(importPath, modulePath):
+		return moduleIdx
+	case stdIdx >= 0 && isStdImport(importPath):
+		return stdIdx
+	case thirdPartyIdx >= 0:
+		return thirdPartyIdx
+	}
+
+	return len(sections)
+}
+
+func sectionName(sections []importSection, idx int) string {
+	if idx < len(sections) {
+		return sections[idx].name
+	}
+
+	return "other"
+}
+
+func hasPathPrefix(importPath, prefix string) bool {
+	return importPath == prefix || strings.HasPrefix(importPath, strings.TrimSuffix(prefix, "/")+"/")
+}
+
+// isStdImport reports whether the import path belongs to the standard library,
+// using the same heuristic as goimports: the first path element has no dot.
+func isStdImport(importPath string) bool {
+	first, _, _ := strings.Cut(importPath, "/")
+	return !strings.Contains(first, ".")
+}
+
+func (a *Analyzer) checkImports(pass *analysis.Pass, node *ast.GenDecl) bool {
+	cfg := a.cfg.Imports
+	if cfg == nil || !cfg.Enabled {
+		a.logger.Verbose("Skipping import checks")
+		return true
+	}
+
+	a.logger.Verbose("Processing imports", log.FieldEnabled, cfg.Enabled, log.FieldSections, cfg.Sections)
+	if !node.Lparen.IsValid() || len(node.Specs) <= 1 {
+		return true
+	}
+
+	sections, err := parseImportSections(cfg.Sections)
+	if err != nil {
+		a.logger.Verbose("Skipping import checks - invalid sections", log.FieldError, err)
+		return true
+	}
+
+	specs := make([]*ast.ImportSpec, 0, len(node.Specs))
+	for _, spec := range node.Specs {
+		importSpec := spec.(*ast.ImportSpec)
+		if importPath(importSpec) == "C" {
+			a.logger.Verbose("Skipping import block with cgo import", log.FieldPosition, pass.Fset.Position(node.Pos()))
+			return true
+		}
+		specs = append(specs, importSpec)
+	}
+
+	modulePath := a.modulePath(pass, node.Pos())
+	indexes := make(map[*ast.ImportSpec]int, len(specs))
+	for _, spec := range specs {
+		indexes[spec] = sectionIndex(sections, modulePath, importPath(spec))
+	}
+
+	groups := groupImports(pass.Fset, specs)
+	misplaced := findMisplacedImports(groups, indexes)
+	if len(misplaced) == 0 {
+		return true
+	}
+
+	fix := a.generateImportsFix(fileOf(pass, node.Pos()), node, specs, indexes)
+	for i, spec := range misplaced {
+		diagnostic := Diagnostic{
+			From:    spec.Pos(),
+			Message: fmt.Sprintf("import %s is misplaced, expected in %s section", spec.Path.Value, sectionName(sections, indexes[spec])),
+		}
+		if i == 0 {
+			diagnostic.Suggestion = fix
+		}
+
+		a.report(pass, diagnostic)
+	}
+
+	return false
+}
+
+// groupImports splits import specs into groups separated by empty lines.
+func groupImports(fset *token.FileSet, specs []*ast.ImportSpec) [][]*ast.ImportSpec {
+	var groups [][]*ast.ImportSpec
+	for i, spec := range specs {
+		if i == 0 || fset.Position(importStart(spec)).Line-fset.Position(importEnd(specs[i-1])).Line > 1 {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], spec)
+	}
+
+	return groups
+}
+
+// findMisplacedImports returns imports that are grouped with imports of another
+// section or whose group is out of the section order. The section of a group is the
+// one most of its imports belong to. Groups are kept in place so that as few imports
+// as possible are reported: those of the groups out of the longest run of groups in
+// section order.
+func findMisplacedImports(groups [][]*ast.ImportSpec, indexes map[*ast.ImportSpec]int) []*ast.ImportSpec {
+	sections := make([]int, len(groups))
+	weights := make([]int, len(groups))
+	for i, group := range groups {
+		sections[i], weights[i] = groupSection(group, indexes)
+	}
+
+	// best[i] is the number of well placed imports of the best run ending with group i
+	best := make([]int, len(groups))
+	prev := make([]int, len(groups))
+	last := -1
+	for i := range groups {
+		best[i], prev[i] = weights[i], -1
+		for j := range i {
+			if sections[j] < sections[i] && best[j]+weights[i] > best[i] {
+				best[i], prev[i] = best[j]+weights[i], j
+			}
+		}
+
+		if last < 0 || best[i] > best[last] {
+			last = i
+		}
+	}
+
+	inOrder := make([]bool, len(groups))
+	for i := last; i >= 0; i = prev[i] {
+		inOrder[i] = true
+	}
+
+	var misplaced []*ast.ImportSpec
+	for i, group := range groups {
+		for _, spec := range group {
+			if !inOrder[i] || indexes[spec] != sections[i] {
+				misplaced = append(misplaced, spec)
+			}
+		}
+	}
+
+	return misplaced
+}
+
+// groupSection returns the section most imports of the group belong to, the first
+// one on ties, and the number of imports belonging to it.
+func groupSection(group []*ast.ImportSpec, indexes map[*ast.ImportSpec]int) (int, int) {
+	counts := make(map[int]int)
+	section := indexes[group[0]]
+	for _, spec := range group {
+		counts[indexes[spec]]++
+		if counts[indexes[spec]] > counts[section] {
+			section = indexes[spec]
+		}
+	}
+
+	return section, counts[section]
+}
+
+// generateImportsFix rewrites the whole import block so that every section forms
+// its own group sorted by import path. Aliases, doc and trailing comments are kept.
+func (a *Analyzer) generateImportsFix(file *ast.File, node *ast.GenDecl, specs []*ast.ImportSpec, indexes map[*ast.ImportSpec]int) *FixSuggestion {
+	if hasFloatingComments(file, node, specs) {
+		a.logger.Verbose("Skipping import fix - block contains comments not attached to imports")
+		return nil
+	}
+
+	sorted := make([]*ast.ImportSpec, len(specs))
+	copy(sorted, specs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if indexes[sorted[i]] != indexes[sorted[j]] {
+			return indexes[sorted[i]] < indexes[sorted[j]]
+		}
+		return importPath(sorted[i]) < importPath(sorted[j])
+	})
+
+	var buf strings.Builder
+	buf.WriteString("(\n")
+	for i, spec := range sorted {
+		if i > 0 && indexes[spec] != indexes[sorted[i-1]] {
+			buf.WriteByte('\n')
+		}
+
+		if spec.Doc != nil {
+			for _, comment := range spec.Doc.List {
+				buf.WriteString("\t" + comment.Text + "\n")
+			}
+		}
+
+		buf.WriteByte('\t')
+		if spec.Name != nil {
+			buf.WriteString(spec.Name.Name + " ")
+		}
+		buf.WriteString(spec.Path.Value)
+
+		if spec.Comment != nil {
+			for _, comment := range spec.Comment.List {
+				buf.WriteString(" " + comment.Text)
+			}
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte(')')
+
+	return &FixSuggestion{
+		From:        node.Lparen,
+		Message:     "Group imports by section",
+		Replacement: []byte(buf.String()),
+		To:          node.Rparen + 1,
+	}
+}
+
+// hasFloatingComments reports whether the import block contains comments that are
+// neither doc nor trailing comments of an import, which a regrouping fix would lose.
+func hasFloatingComments(file *ast.File, node *ast.GenDecl, specs []*ast.ImportSpec) bool {
+	if file == nil {
+		return true
+	}
+
+	attached := make(map[*ast.CommentGroup]bool)
+	for _, spec := range specs {
+		attached[spec.Doc] = true
+		attached[spec.Comment] = true
+	}
+
+	for _, group := range file.Comments {
+		if group.Pos() > node.Lparen && group.End() < node.Rparen && !attached[group] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func importStart(spec *ast.ImportSpec) token.Pos {
+	if spec.Doc != nil {
+		return spec.Doc.Pos()
+	}
+
+	return spec.Pos()
+}
+
+func importEnd(spec *ast.ImportSpec) token.Pos {
+	if spec.Comment != nil {
+		return spec.Comment.End()
+	}
+
+	return spec.End()
+}
+
+func importPath(spec *ast.ImportSpec) string {
+	path, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return spec.Path.Value
+	}
+
+	return path
+}
+
+// modulePath returns the path of the module the analyzed package belongs to. The
+// path found in go.mod is cached for the package, which has many import blocks.
+func (a *Analyzer) modulePath(pass *analysis.Pass, pos token.Pos) string {
+	if a.cfg.Imports.Module != "" {
+		return a.cfg.Imports.Module
+	}
+
+	if pass.Module != nil && pass.Module.Path != "" {
+		return pass.Module.Path
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if path, ok := a.modules[pass.Pkg]; ok {
+		return path
+	}
+
+	path := findModulePath(filepath.Dir(pass.Fset.Position(pos).Filename))
+	if a.modules == nil {
+		a.modules = make(map[*types.Package]string)
+	}
+	a.modules[pass.Pkg] = path
+
+	return path
+}
+
+// findModulePath returns the module path of the closest go.mod in dir or its parents.
+func findModulePath(dir string) string {
+	for {
+		content, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			return modfile.ModulePath(content)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
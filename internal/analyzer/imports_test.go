@@ -0,0 +1,73 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.tomakado.io/sortir/internal/config"
+)
+
+func TestParseImportSections(t *testing.T) {
+	sections, err := parseImportSections([]string{"std", "third-party", "prefix(github.com/acme)", "module"})
+	require.NoError(t, err)
+	require.Equal(t, []importSection{
+		{kind: importSectionStd, name: "std"},
+		{kind: importSectionThirdParty, name: "third-party"},
+		{kind: importSectionPrefix, name: "prefix(github.com/acme)", prefix: "github.com/acme"},
+		{kind: importSectionModule, name: "module"},
+	}, sections)
+
+	_, err = parseImportSections([]string{"stdlib"})
+	require.Error(t, err)
+
+	_, err = parseImportSections([]string{"prefix()"})
+	require.Error(t, err)
+}
+
+func TestInvalidImportSections(t *testing.T) {
+	a := New()
+	require.Error(t, a.Analyzer().Flags.Set(config.FlagImportsSections, "std,stdlib"))
+
+	cfg := config.New()
+	cfg.Imports.Enabled = true
+	cfg.Imports.Sections = config.List{"std", "vendored"}
+	require.ErrorContains(t, a.WithConfig(cfg).validateConfig(), `unknown import section: "vendored"`)
+
+	cfg.Imports.Enabled = false
+	require.NoError(t, a.validateConfig())
+}
+
+func TestSectionIndex(t *testing.T) {
+	sections, err := parseImportSections([]string{"std", "third-party", "prefix(github.com/acme)", "prefix(github.com/acme/internal)", "module"})
+	require.NoError(t, err)
+
+	const modulePath = "example.com/app"
+
+	tests := []struct {
+		importPath string
+		want       int
+	}{
+		{importPath: "fmt", want: 0},
+		{importPath: "net/http", want: 0},
+		{importPath: "golang.org/x/tools", want: 1},
+		{importPath: "github.com/acme/lib", want: 2},
+		{importPath: "github.com/acme-corp/lib", want: 1},
+		{importPath: "github.com/acme/internal/log", want: 3},
+		{importPath: "example.com/app", want: 4},
+		{importPath: "example.com/app/internal/config", want: 4},
+		{importPath: "example.com/application", want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			require.Equal(t, tt.want, sectionIndex(sections, modulePath, tt.importPath))
+		})
+	}
+
+	t.Run("no third-party section", func(t *testing.T) {
+		sections, err := parseImportSections([]string{"std", "module"})
+		require.NoError(t, err)
+		require.Equal(t, 2, sectionIndex(sections, modulePath, "golang.org/x/tools"))
+	})
+}
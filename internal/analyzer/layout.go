@@ -0,0 +1,215 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/log"
+)
+
+// binaryFuncs are the encoding/binary functions encoding struct fields in order.
+// The data they encode or decode is their last argument.
+var binaryFuncs = map[string]bool{
+	"Append": true, "Decode": true, "Encode": true, "Read": true, "Size": true, "Write": true,
+}
+
+// layoutReason returns why the memory layout of the struct matters, making its
+// fields unsafe to reorder, or "" if it does not.
+func (a *Analyzer) layoutReason(pass *analysis.Pass, node *ast.StructType) string {
+	if pass.TypesInfo == nil || pass.Pkg == nil {
+		return ""
+	}
+
+	structType, ok := pass.TypesInfo.TypeOf(node).(*types.Struct)
+	if !ok {
+		return ""
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	reasons, ok := a.layouts[pass.Pkg]
+	if !ok {
+		reasons = collectLayoutSensitive(pass)
+		if a.layouts == nil {
+			a.layouts = make(map[*types.Package]map[*types.Struct]string)
+		}
+		a.layouts[pass.Pkg] = reasons
+	}
+
+	return reasons[structType]
+}
+
+// collectLayoutSensitive finds the structs of the package whose field order is
+// relied upon: they are encoded with encoding/binary, passed to unsafe.Offsetof,
+// declared in cgo files, marked with structs.HostLayout, have 64-bit fields used
+// with sync/atomic functions, or are written as unkeyed literals.
+func collectLayoutSensitive(pass *analysis.Pass) map[*types.Struct]string {
+	reasons := make(map[*types.Struct]string)
+	mark := func(t types.Type, reason string) {
+		if s := structOf(t); s != nil {
+			if _, ok := reasons[s]; !ok {
+				reasons[s] = reason
+			}
+		}
+	}
+
+	for _, file := range pass.Files {
+		cgo := false
+		for _, spec := range file.Imports {
+			if spec.Path.Value == `"C"` {
+				cgo = true
+			}
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.StructType:
+				if cgo {
+					mark(pass.TypesInfo.TypeOf(n), "declared in a cgo file")
+				}
+
+				for _, field := range n.Fields.List {
+					if isNamedType(pass.TypesInfo.TypeOf(field.Type), "structs", "HostLayout") {
+						mark(pass.TypesInfo.TypeOf(n), "marked with structs.HostLayout")
+					}
+				}
+			case *ast.CompositeLit:
+				if len(n.Elts) > 0 {
+					if _, keyed := n.Elts[0].(*ast.KeyValueExpr); !keyed {
+						mark(pass.TypesInfo.TypeOf(n), "written as an unkeyed literal")
+					}
+				}
+			case *ast.CallExpr:
+				markCall(pass, n, mark)
+			}
+
+			return true
+		})
+	}
+
+	return reasons
+}
+
+// markCall marks the structs whose layout the call relies on.
+func markCall(pass *analysis.Pass, call *ast.CallExpr, mark func(types.Type, string)) {
+	pkg, name := calleeOf(pass, call)
+	switch {
+	case pkg == "encoding/binary" && binaryFuncs[name] && len(call.Args) > 0:
+		data := call.Args[len(call.Args)-1]
+		mark(elemOf(pass.TypesInfo.TypeOf(data)), "encoded with encoding/binary")
+	case pkg == "unsafe" && name == "Offsetof" && len(call.Args) == 1:
+		if sel, ok := ast.Unparen(call.Args[0]).(*ast.SelectorExpr); ok {
+			mark(fieldOwner(pass, sel), "has fields passed to unsafe.Offsetof")
+		}
+	case pkg == "sync/atomic" && (strings.HasSuffix(name, "Int64") || strings.HasSuffix(name, "Uint64")) && len(call.Args) > 0:
+		addr, ok := ast.Unparen(call.Args[0]).(*ast.UnaryExpr)
+		if !ok {
+			return
+		}
+
+		if sel, ok := ast.Unparen(addr.X).(*ast.SelectorExpr); ok {
+			mark(fieldOwner(pass, sel), "has 64-bit fields used with sync/atomic")
+		}
+	}
+}
+
+// calleeOf returns the import path and name of a function called through its
+// package, like binary.Write.
+func calleeOf(pass *analysis.Pass, call *ast.CallExpr) (string, string) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return "", ""
+	}
+
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return "", ""
+	}
+
+	pkgName, ok := pass.TypesInfo.Uses[ident].(*types.PkgName)
+	if !ok {
+		return "", ""
+	}
+
+	return pkgName.Imported().Path(), sel.Sel.Name
+}
+
+// fieldOwner returns the type of the struct declaring the selected field, following
+// embedded fields, or nil if the selector is not a field.
+func fieldOwner(pass *analysis.Pass, sel *ast.SelectorExpr) types.Type {
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return nil
+	}
+
+	owner := selection.Recv()
+	index := selection.Index()
+	for _, i := range index[:len(index)-1] {
+		s := structOf(owner)
+		if s == nil {
+			return nil
+		}
+		owner = s.Field(i).Type()
+	}
+
+	return owner
+}
+
+// elemOf returns the type of the values stored by pointers, slices and arrays.
+func elemOf(t types.Type) types.Type {
+	for t != nil {
+		switch u := t.Underlying().(type) {
+		case *types.Pointer:
+			t = u.Elem()
+		case *types.Slice:
+			t = u.Elem()
+		case *types.Array:
+			t = u.Elem()
+		default:
+			return t
+		}
+	}
+
+	return nil
+}
+
+// structOf returns the struct type of a struct or a pointer to one.
+func structOf(t types.Type) *types.Struct {
+	if t == nil {
+		return nil
+	}
+
+	if pointer, ok := t.Underlying().(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	s, _ := t.Underlying().(*types.Struct)
+	return s
+}
+
+// isNamedType reports whether t is the named type pkg.name.
+func isNamedType(t types.Type, pkg, name string) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkg && obj.Name() == name
+}
+
+// checkLayout reports whether the fields of the struct may be reordered, logging
+// why they may not.
+func (a *Analyzer) checkLayout(pass *analysis.Pass, node *ast.StructType) bool {
+	reason := a.layoutReason(pass, node)
+	if reason == "" {
+		return true
+	}
+
+	a.logger.Verbose("Skipping struct fields - field order matters", log.FieldReason, reason, log.FieldPosition, pass.Fset.Position(node.Pos()))
+	return false
+}
@@ -0,0 +1,278 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/log"
+)
+
+// elementNames returns the names declared by a multi-name element, like B, A in
+// "B, A int". Value specs assigning the results of a single multi-value expression,
+// like "b, a = f()", are skipped: the results cannot follow their names.
+func elementNames(node ast.Node) []*ast.Ident {
+	switch n := node.(type) {
+	case *ast.Field:
+		return n.Names
+	case *ast.ValueSpec:
+		if hasMovableValues(n) {
+			return n.Names
+		}
+	}
+
+	return nil
+}
+
+// hasMovableValues reports whether the values of a value spec can move along with
+// its names: there are none, or one for each name.
+func hasMovableValues(spec *ast.ValueSpec) bool {
+	return len(spec.Values) == 0 || len(spec.Values) == len(spec.Names)
+}
+
+// nameOrder returns the indexes of the names in sorted order.
+func nameOrder(names []*ast.Ident) []int {
+	order := make([]int, len(names))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return names[order[i]].Name < names[order[j]].Name
+	})
+
+	return order
+}
+
+// firstName returns the name a multi-name element is ordered by: the first one after sorting.
+func firstName(names []*ast.Ident) string {
+	first := names[0].Name
+	for _, name := range names[1:] {
+		first = min(first, name.Name)
+	}
+
+	return first
+}
+
+// sortedNames returns the names sorted and joined the way they are written in source.
+func sortedNames(names []*ast.Ident) string {
+	sorted := make([]string, len(names))
+	for i, name := range names {
+		sorted[i] = name.Name
+	}
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, ", ")
+}
+
+// checkNamesSorted reports elements declaring several names out of order, like
+// "B, A int". Groups whose elements are out of order are skipped, their fix sorts
+// names as well, except for names taking the results of a multi-value expression.
+// Names whose values have side effects are reported without a fix.
+func (a *Analyzer) checkNamesSorted(pass *analysis.Pass, groups [][]Metadata, prefix, msg string) bool {
+	allSorted := true
+	for _, group := range groups {
+		groupSorted := isGroupSorted(group)
+		for _, meta := range group {
+			if spec, ok := meta.Node.(*ast.ValueSpec); ok && !hasMovableValues(spec) {
+				if !a.checkUnmovableNamesSorted(pass, spec, meta, prefix, msg) {
+					allSorted = false
+				}
+				continue
+			}
+
+			if !groupSorted {
+				continue
+			}
+
+			names := elementNames(meta.Node)
+			if len(names) <= 1 {
+				continue
+			}
+
+			if !hasPrefixOrGlobal(meta.Value, prefix, a.cfg.GlobalPrefix) {
+				continue
+			}
+
+			if sortedNames(names) == joinNames(names) {
+				continue
+			}
+
+			// Values are evaluated in source order, sorting them changes when their effects happen
+			if !a.cfg.FixSideEffects && hasSideEffects(pass, []Metadata{meta}) {
+				allSorted = false
+				a.logger.Verbose("Found unsorted names - values have side effects", log.FieldElement, joinNames(names), log.FieldPosition, pass.Fset.Position(names[0].Pos()))
+				a.report(pass, Diagnostic{
+					Category: getCategory(msg),
+					From:     names[0].Pos(),
+					Message:  msg + " (names left unsorted: their values have side effects)",
+				})
+				continue
+			}
+
+			replacement, to := a.sortedNameList(pass, names), names[len(names)-1].End()
+
+			// Values are moved along with their names, so the replacement spans them too
+			if spec, ok := meta.Node.(*ast.ValueSpec); ok && len(spec.Values) > 0 {
+				replacement, to = a.sortedSpec(pass, spec), spec.End()
+			}
+
+			allSorted = false
+			a.logger.Verbose("Found unsorted names", log.FieldElement, joinNames(names), log.FieldPosition, pass.Fset.Position(names[0].Pos()))
+			a.report(pass, Diagnostic{
+				Category: getCategory(msg),
+				From:     names[0].Pos(),
+				Message:  msg,
+				Suggestion: &FixSuggestion{
+					From:        names[0].Pos(),
+					Message:     "Sort names",
+					Replacement: []byte(replacement),
+					To:          to,
+				},
+			})
+		}
+	}
+
+	return allSorted
+}
+
+// checkUnmovableNamesSorted reports value specs whose names are out of order but
+// take the results of a single multi-value expression, like "b, a = f()". Their
+// names cannot be sorted, so the diagnostic tells why it comes without a fix.
+func (a *Analyzer) checkUnmovableNamesSorted(pass *analysis.Pass, spec *ast.ValueSpec, meta Metadata, prefix, msg string) bool {
+	if len(spec.Names) <= 1 || !hasPrefixOrGlobal(meta.Value, prefix, a.cfg.GlobalPrefix) {
+		return true
+	}
+
+	if sortedNames(spec.Names) == joinNames(spec.Names) {
+		return true
+	}
+
+	a.logger.Verbose("Found unsorted names - values come from a single multi-value expression", log.FieldElement, joinNames(spec.Names), log.FieldPosition, pass.Fset.Position(spec.Pos()))
+	a.report(pass, Diagnostic{
+		Category: getCategory(msg),
+		From:     spec.Names[0].Pos(),
+		Message:  msg + " (names left unsorted: their values come from a single multi-value expression)",
+	})
+
+	return false
+}
+
+func isGroupSorted(group []Metadata) bool {
+	for i := 1; i < len(group); i++ {
+		if lessMetadata(group[i], group[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func joinNames(names []*ast.Ident) string {
+	joined := make([]string, len(names))
+	for i, name := range names {
+		joined[i] = name.Name
+	}
+
+	return strings.Join(joined, ", ")
+}
+
+// sortedSpec returns the source of a value spec, from its first name to its end,
+// with the names sorted and the values moved along with them: "b, a = 2, 1"
+// becomes "a, b = 1, 2".
+func (a *Analyzer) sortedSpec(pass *analysis.Pass, spec *ast.ValueSpec) string {
+	order := nameOrder(spec.Names)
+	names := a.sortedNameList(pass, spec.Names)
+
+	values, ok := a.permuteSource(pass, toNodes(spec.Values), order)
+	if !ok {
+		sortedValues := make([]string, 0, len(spec.Values))
+		for _, i := range order {
+			sortedValues = append(sortedValues, a.extractNodeSource(pass, spec.Values[i]))
+		}
+		values = strings.Join(sortedValues, ", ")
+	}
+
+	// The type and the equals sign between names and values are kept as written
+	end := spec.End()
+	if len(spec.Values) > 0 {
+		end = spec.Values[0].Pos()
+	}
+
+	between, ok := a.sourceBetween(pass, spec.Names[len(spec.Names)-1].End(), end)
+	if !ok {
+		if spec.Type != nil {
+			between = " " + a.formatNode(pass, spec.Type)
+		}
+		if len(spec.Values) > 0 {
+			between += " = "
+		}
+	}
+
+	return names + between + values
+}
+
+// sortedNameList returns the source of a list of names with the names sorted and
+// the separators between them kept as written, like "a,b" for "b,a".
+func (a *Analyzer) sortedNameList(pass *analysis.Pass, names []*ast.Ident) string {
+	if list, ok := a.permuteSource(pass, toNodes(names), nameOrder(names)); ok {
+		return list
+	}
+
+	return sortedNames(names)
+}
+
+// permuteSource returns the source from the first node to the last one with the
+// nodes placed in the given order. The text between nodes, like commas and line
+// breaks, stays where it is.
+func (a *Analyzer) permuteSource(pass *analysis.Pass, nodes []ast.Node, order []int) (string, bool) {
+	if len(nodes) == 0 {
+		return "", true
+	}
+
+	var b strings.Builder
+	for i, idx := range order {
+		text, ok := a.sourceBetween(pass, nodes[idx].Pos(), nodes[idx].End())
+		if !ok {
+			return "", false
+		}
+		b.WriteString(text)
+
+		if i == len(order)-1 {
+			break
+		}
+
+		separator, ok := a.sourceBetween(pass, nodes[i].End(), nodes[i+1].Pos())
+		if !ok {
+			return "", false
+		}
+		b.WriteString(separator)
+	}
+
+	return b.String(), true
+}
+
+func toNodes[T ast.Node](list []T) []ast.Node {
+	nodes := make([]ast.Node, len(list))
+	for i, node := range list {
+		nodes[i] = node
+	}
+
+	return nodes
+}
+
+// sourceBetween returns the source text between two positions of the same file.
+func (a *Analyzer) sourceBetween(pass *analysis.Pass, from, to token.Pos) (string, bool) {
+	if pass.ReadFile == nil {
+		return "", false
+	}
+
+	content, file := a.getFileContent(pass, from)
+	if content == nil || file == nil {
+		return "", false
+	}
+
+	return string(content[file.Offset(from):file.Offset(to)]), true
+}
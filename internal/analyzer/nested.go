@@ -0,0 +1,183 @@
+package analyzer
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/log"
+)
+
+// nestedFix is the fix of a group nested in an element of another group, like the
+// fields of a struct type used as a field type, and the position it is reported at.
+type nestedFix struct {
+	From       token.Pos
+	Suggestion *FixSuggestion
+}
+
+// withNestedFixes applies the fixes of the groups nested in the elements of a group
+// to the replacement of the group. The edits of both fixes would overlap, so the
+// outer fix carries the nested ones and their own diagnostics come without a fix.
+// Nested fixes whose source cannot be found in the replacement are left out.
+func (a *Analyzer) withNestedFixes(pass *analysis.Pass, group []Metadata, replacement []byte, from, to token.Pos) []byte {
+	if pass.ReadFile == nil {
+		return replacement
+	}
+
+	content, file := a.getFileContent(pass, from)
+	if content == nil || file == nil {
+		return replacement
+	}
+
+	fixes := a.nestedFixes(pass, group)
+	done := make([]bool, len(fixes))
+	for i, nested := range fixes {
+		if done[i] || !within(nested.Suggestion, from, to) {
+			continue
+		}
+
+		// Nested groups with the same source, like equal tags of several fields, get
+		// the same fix, which is applied to all of them at once
+		original := content[file.Offset(nested.Suggestion.From):file.Offset(nested.Suggestion.To)]
+		same := []nestedFix{nested}
+		for j := i + 1; j < len(fixes); j++ {
+			fix := fixes[j].Suggestion
+			if !done[j] && within(fix, from, to) && bytes.Equal(content[file.Offset(fix.From):file.Offset(fix.To)], original) {
+				done[j] = true
+				if bytes.Equal(fix.Replacement, nested.Suggestion.Replacement) {
+					same = append(same, fixes[j])
+				}
+			}
+		}
+
+		if len(original) == 0 || bytes.Count(replacement, original) != len(same) {
+			a.logger.Verbose("Skipping nested fix - its source is not unique in the outer fix", log.FieldPosition, pass.Fset.Position(nested.From))
+			continue
+		}
+
+		replacement = bytes.ReplaceAll(replacement, original, nested.Suggestion.Replacement)
+		for _, fix := range same {
+			a.carry(pass, fix.From)
+		}
+	}
+
+	return replacement
+}
+
+// within reports whether the fix lies within the range.
+func within(fix *FixSuggestion, from, to token.Pos) bool {
+	return fix.From >= from && fix.To <= to
+}
+
+// nestedFixes returns the fixes of the groups nested in the elements of a group.
+// The nested nodes are checked with a copy of the pass whose diagnostics are
+// collected instead of reported.
+func (a *Analyzer) nestedFixes(pass *analysis.Pass, group []Metadata) []nestedFix {
+	collector := *pass
+	var fixes []nestedFix
+
+	a.mu.Lock()
+	if a.collectors == nil {
+		a.collectors = make(map[*analysis.Pass]*[]nestedFix)
+	}
+	a.collectors[&collector] = &fixes
+	a.mu.Unlock()
+
+	defer func() {
+		a.mu.Lock()
+		delete(a.collectors, &collector)
+		a.mu.Unlock()
+	}()
+
+	for _, meta := range group {
+		if field, ok := meta.Node.(*ast.Field); ok {
+			// Tags are checked along with the struct declaring the field
+			a.checkFieldTag(&collector, field)
+		}
+
+		for _, node := range elementNodes(meta.Node) {
+			if node == nil {
+				continue
+			}
+
+			ast.Inspect(node, func(n ast.Node) bool {
+				if n != nil {
+					a.CheckNode(&collector, n)
+				}
+
+				return true
+			})
+		}
+	}
+
+	return fixes
+}
+
+// elementNodes returns the syntax nodes an element of a group consists of, the
+// value of a variadic pair may be missing.
+func elementNodes(node ast.Node) []ast.Node {
+	switch n := node.(type) {
+	case testCase:
+		return []ast.Node{n.Expr}
+	case switchCase:
+		return []ast.Node{n.CaseClause}
+	case unionTerm:
+		return []ast.Node{n.Expr}
+	case variadicPair:
+		return []ast.Node{n.Key, n.Value}
+	case *ast.Field, *ast.KeyValueExpr, *ast.ValueSpec, ast.Expr:
+		return []ast.Node{n}
+	default:
+		return nil
+	}
+}
+
+// collect records the fix of the diagnostic if the pass collects nested fixes,
+// and reports whether it does.
+func (a *Analyzer) collect(pass *analysis.Pass, diagnostic Diagnostic) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	fixes, ok := a.collectors[pass]
+	if ok && diagnostic.Suggestion != nil {
+		*fixes = append(*fixes, nestedFix{From: diagnostic.From, Suggestion: diagnostic.Suggestion})
+	}
+
+	return ok
+}
+
+// collecting reports whether the pass collects nested fixes.
+func (a *Analyzer) collecting(pass *analysis.Pass) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, ok := a.collectors[pass]
+	return ok
+}
+
+// carry records that the fix of the diagnostic at the position is carried by the
+// fix of an enclosing group.
+func (a *Analyzer) carry(pass *analysis.Pass, pos token.Pos) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.carried == nil {
+		a.carried = make(map[*types.Package]map[token.Pos]bool)
+	}
+	if a.carried[pass.Pkg] == nil {
+		a.carried[pass.Pkg] = make(map[token.Pos]bool)
+	}
+	a.carried[pass.Pkg][pos] = true
+}
+
+// isCarried reports whether the fix of the diagnostic at the position is carried
+// by the fix of an enclosing group.
+func (a *Analyzer) isCarried(pass *analysis.Pass, pos token.Pos) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.carried[pass.Pkg][pos]
+}
@@ -0,0 +1,96 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/config"
+	"go.tomakado.io/sortir/internal/log"
+)
+
+// switchCase is a case clause of an expression switch, sorted by its first expression.
+type switchCase struct {
+	*ast.CaseClause
+	commented
+}
+
+func (a *Analyzer) checkSwitchStmt(pass *analysis.Pass, node *ast.SwitchStmt) bool {
+	cfg := a.cfg.SwitchCases
+	if cfg == nil || !cfg.Enabled {
+		a.logger.Verbose("Skipping switch case checks")
+		return true
+	}
+
+	if node.Tag == nil || len(node.Body.List) <= 1 {
+		return true
+	}
+
+	a.logger.Verbose("Processing switch cases", log.FieldEnabled, cfg.Enabled, log.FieldPrefix, cfg.Prefix, log.FieldOrder, cfg.Order)
+
+	clauses := make([]ast.Node, 0, len(node.Body.List))
+	for _, stmt := range node.Body.List {
+		clause := stmt.(*ast.CaseClause)
+		if hasFallthrough(clause) {
+			a.logger.Verbose("Skipping switch with fallthrough", log.FieldPosition, pass.Fset.Position(node.Pos()))
+			return true
+		}
+
+		for _, expr := range clause.List {
+			if tv, ok := pass.TypesInfo.Types[expr]; !ok || tv.Value == nil {
+				a.logger.Verbose("Skipping switch - not all cases are constants", log.FieldPosition, pass.Fset.Position(expr.Pos()))
+				return true
+			}
+		}
+
+		clauses = append(clauses, clause)
+	}
+
+	// The default clause keeps its place, cases before and after it are sorted separately
+	comments := collectComments(pass, node.Body.Lbrace, node.Body.Rbrace, clauses)
+	var metadata [][]Metadata
+	var group []switchCase
+	for i, clause := range clauses {
+		caseClause := clause.(*ast.CaseClause)
+		if caseClause.List != nil {
+			group = append(group, switchCase{CaseClause: caseClause, commented: comments[i]})
+		}
+
+		if caseClause.List == nil || i == len(clauses)-1 {
+			metadata = append(metadata, extractMetadata(pass, group, extractSwitchCase, true)...)
+			group = nil
+		}
+	}
+
+	setNumericKeys(pass, metadata)
+	if cfg.Order == config.OrderDeclaration {
+		a.rankByConstDeclaration(pass, metadata)
+	}
+
+	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, cfg.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
+	return a.checkElementsSorted(
+		pass,
+		metadata,
+		cfg.Prefix,
+		"switch cases are not sorted",
+	)
+}
+
+// hasFallthrough reports whether the clause falls through to the next one,
+// which makes the order of clauses meaningful.
+func hasFallthrough(clause *ast.CaseClause) bool {
+	if len(clause.Body) == 0 {
+		return false
+	}
+
+	branch, ok := clause.Body[len(clause.Body)-1].(*ast.BranchStmt)
+	return ok && branch.Tok == token.FALLTHROUGH
+}
+
+func extractSwitchCase(pass *analysis.Pass, node switchCase) (string, token.Pos, int) {
+	value := getKeyString(node.List[0])
+	pos := node.Pos()
+	line := pass.Fset.File(pos).Line(pos)
+	return value, pos, line
+}
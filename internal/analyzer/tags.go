@@ -0,0 +1,205 @@
+package analyzer
+
+import (
+	"go/ast"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/log"
+)
+
+// structTagPair is a single key:"value" pair of a struct tag, the value is kept quoted as in source.
+type structTagPair struct {
+	Key   string
+	Value string
+}
+
+func (a *Analyzer) checkStructTags(pass *analysis.Pass, node *ast.StructType) bool {
+	cfg := a.cfg.StructTags
+	if cfg == nil || !cfg.Enabled {
+		a.logger.Verbose("Skipping struct tag checks")
+		return true
+	}
+
+	a.logger.Verbose("Processing struct tags", log.FieldEnabled, cfg.Enabled, log.FieldPrefix, cfg.Prefix, log.FieldOrder, cfg.Order)
+
+	allSorted := true
+	for _, field := range node.Fields.List {
+		if !a.checkFieldTag(pass, field) {
+			allSorted = false
+		}
+	}
+
+	return allSorted
+}
+
+// checkFieldTag checks the tag of a field whose name matches the prefix.
+func (a *Analyzer) checkFieldTag(pass *analysis.Pass, field *ast.Field) bool {
+	cfg := a.cfg.StructTags
+	if cfg == nil || !cfg.Enabled || field.Tag == nil {
+		return true
+	}
+
+	var name string
+	if len(field.Names) > 0 {
+		name = field.Names[0].Name
+	} else {
+		name = getTypeString(field.Type)
+	}
+
+	if !hasPrefixOrGlobal(name, cfg.Prefix, a.cfg.GlobalPrefix) {
+		a.logger.Verbose("Skipping field - no matching prefix", log.FieldElement, name, log.FieldPrefix, cfg.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
+		return true
+	}
+
+	return a.checkStructTag(pass, field.Tag)
+}
+
+func (a *Analyzer) checkStructTag(pass *analysis.Pass, tag *ast.BasicLit) bool {
+	content, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return true
+	}
+
+	pairs, ok := parseStructTag(content)
+	if !ok {
+		a.logger.Verbose("Skipping malformed struct tag", log.FieldElement, tag.Value, log.FieldPosition, pass.Fset.Position(tag.Pos()))
+		return true
+	}
+
+	sorted := make([]structTagPair, len(pairs))
+	copy(sorted, pairs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return a.lessStructTagKey(sorted[i].Key, sorted[j].Key)
+	})
+
+	unsortedIndex := -1
+	for i := range pairs {
+		if pairs[i].Key != sorted[i].Key {
+			unsortedIndex = i
+			break
+		}
+	}
+
+	if unsortedIndex < 0 {
+		return true
+	}
+
+	a.logger.Verbose("Found unsorted struct tag keys", log.FieldElement, tag.Value, log.FieldPosition, pass.Fset.Position(tag.Pos()))
+	a.report(pass, Diagnostic{
+		From:    tag.Pos(),
+		Message: "struct tag keys are not sorted",
+		Suggestion: &FixSuggestion{
+			From:        tag.Pos(),
+			Message:     "Sort struct tag keys",
+			Replacement: []byte(formatStructTag(sorted, tagSeparators(content, pairs), tag.Value[0] == '`')),
+			To:          tag.End(),
+		},
+	})
+
+	return false
+}
+
+// lessStructTagKey orders keys listed in config first (in the configured order),
+// followed by all other keys in alphabetical order.
+func (a *Analyzer) lessStructTagKey(left, right string) bool {
+	leftRank, rightRank := a.structTagKeyRank(left), a.structTagKeyRank(right)
+	if leftRank != rightRank {
+		return leftRank < rightRank
+	}
+
+	return left < right
+}
+
+func (a *Analyzer) structTagKeyRank(key string) int {
+	for i, ordered := range a.cfg.StructTags.Order {
+		if ordered == key {
+			return i
+		}
+	}
+
+	return len(a.cfg.StructTags.Order)
+}
+
+// parseStructTag splits the tag into key:"value" pairs following the conventions of reflect.StructTag.
+// It returns false if the tag does not follow the conventions.
+func parseStructTag(tag string) ([]structTagPair, bool) {
+	var pairs []structTagPair
+
+	for {
+		// Skip leading space
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		tag = tag[i:]
+		if tag == "" {
+			return pairs, true
+		}
+
+		// Scan to colon. A space, a quote or a control character is a syntax error.
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return nil, false
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		// Scan quoted string to find value
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return nil, false
+		}
+		value := tag[:i+1]
+		tag = tag[i+1:]
+
+		if _, err := strconv.Unquote(value); err != nil {
+			return nil, false
+		}
+
+		pairs = append(pairs, structTagPair{Key: key, Value: value})
+	}
+}
+
+// tagSeparators returns the spaces around the pairs of a tag: before each pair and
+// after the last one.
+func tagSeparators(tag string, pairs []structTagPair) []string {
+	separators := make([]string, 0, len(pairs)+1)
+	for _, pair := range pairs {
+		rest := strings.TrimLeft(tag, " ")
+		separators = append(separators, tag[:len(tag)-len(rest)])
+		tag = rest[len(pair.Key)+1+len(pair.Value):]
+	}
+
+	return append(separators, tag)
+}
+
+// formatStructTag renders the pairs as a struct tag literal, either raw or interpreted.
+// The pairs are put between the separators of the original tag, so spacing is kept.
+func formatStructTag(pairs []structTagPair, separators []string, raw bool) string {
+	var b strings.Builder
+	for i, pair := range pairs {
+		b.WriteString(separators[i])
+		b.WriteString(pair.Key + ":" + pair.Value)
+	}
+	b.WriteString(separators[len(pairs)])
+
+	content := b.String()
+	if raw {
+		return "`" + content + "`"
+	}
+
+	return strconv.Quote(content)
+}
@@ -0,0 +1,78 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStructTag(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   string
+		pairs []structTagPair
+		ok    bool
+	}{
+		{
+			name: "empty",
+			tag:  "",
+			ok:   true,
+		},
+		{
+			name: "multiple keys",
+			tag:  `json:"id,omitempty"  db:"id"`,
+			pairs: []structTagPair{
+				{Key: "json", Value: `"id,omitempty"`},
+				{Key: "db", Value: `"id"`},
+			},
+			ok: true,
+		},
+		{
+			name: "escaped quote in value",
+			tag:  `validate:"oneof=\"a b\"" json:"v"`,
+			pairs: []structTagPair{
+				{Key: "validate", Value: `"oneof=\"a b\""`},
+				{Key: "json", Value: `"v"`},
+			},
+			ok: true,
+		},
+		{
+			name: "unquoted value",
+			tag:  `json:id`,
+		},
+		{
+			name: "missing closing quote",
+			tag:  `json:"id`,
+		},
+		{
+			name: "key without value",
+			tag:  `json`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs, ok := parseStructTag(tt.tag)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.pairs, pairs)
+		})
+	}
+}
+
+func TestFormatStructTag(t *testing.T) {
+	pairs := []structTagPair{
+		{Key: "json", Value: `"name"`},
+		{Key: "db", Value: `"name"`},
+	}
+
+	require.Equal(t, "`json:\"name\" db:\"name\"`", formatStructTag(pairs, []string{"", " ", ""}, true))
+	require.Equal(t, `"json:\"name\" db:\"name\""`, formatStructTag(pairs, []string{"", " ", ""}, false))
+	require.Equal(t, "`json:\"name\"   db:\"name\"`", formatStructTag(pairs, []string{"", "   ", ""}, true))
+}
+
+func TestTagSeparators(t *testing.T) {
+	tag := ` db:"id"   json:"id" `
+	pairs, ok := parseStructTag(tag)
+	require.True(t, ok)
+	require.Equal(t, []string{" ", "   ", " "}, tagSeparators(tag, pairs))
+}
@@ -0,0 +1,189 @@
+package analyzer
+
+import (
+	"bytes"
+	"go/ast"
+	"go/constant"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"go.tomakado.io/sortir/internal/log"
+)
+
+// testCase is an element of a table-driven test, e.g. {name: "empty", input: ""},
+// sorted by the value of its key field.
+type testCase struct {
+	ast.Expr
+	commented
+
+	field      string
+	fieldIndex int
+	lit        *ast.CompositeLit
+}
+
+func (a *Analyzer) checkTestCases(pass *analysis.Pass, node *ast.CompositeLit) bool {
+	cfg := a.cfg.TestCases
+	if cfg == nil || !cfg.Enabled {
+		a.logger.Verbose("Skipping test case checks")
+		return true
+	}
+
+	// Only tables of test files are checked, order elsewhere may be meaningful
+	if len(node.Elts) <= 1 || !strings.HasSuffix(pass.Fset.Position(node.Pos()).Filename, "_test.go") {
+		return true
+	}
+
+	fieldIndex, ok := testCaseFieldIndex(pass, node, cfg.Field)
+	if !ok {
+		return true
+	}
+
+	a.logger.Verbose("Processing test cases", log.FieldEnabled, cfg.Enabled, log.FieldPrefix, cfg.Prefix, log.FieldField, cfg.Field)
+
+	elts := make([]ast.Node, 0, len(node.Elts))
+	for _, elt := range node.Elts {
+		elts = append(elts, elt)
+	}
+
+	comments := collectComments(pass, node.Lbrace, node.Rbrace, elts)
+	cases := make([]testCase, 0, len(node.Elts))
+	for i, elt := range node.Elts {
+		lit := testCaseLit(elt)
+		if lit == nil {
+			a.logger.Verbose("Skipping table - not all elements are composite literals", log.FieldPosition, pass.Fset.Position(elt.Pos()))
+			return true
+		}
+
+		cases = append(cases, testCase{
+			Expr:       elt,
+			commented:  comments[i],
+			field:      cfg.Field,
+			fieldIndex: fieldIndex,
+			lit:        lit,
+		})
+	}
+
+	a.logger.Verbose("Extracting metadata", log.FieldElementsCount, len(cases), log.FieldIgnoreGroups, a.cfg.IgnoreGroups)
+
+	// Test cases usually span several lines, so groups are separated by empty lines
+	// between the end of one case and the start of the next rather than by start lines
+	var metadata [][]Metadata
+	for _, group := range groupTestCases(pass.Fset, cases, a.cfg.IgnoreGroups) {
+		metadata = append(metadata, extractMetadata(pass, group, extractTestCase, true)...)
+	}
+
+	a.logger.Verbose("Checking elements sorted", log.FieldGroupsCount, len(metadata), log.FieldPrefix, cfg.Prefix, log.FieldGlobalPrefix, a.cfg.GlobalPrefix)
+	return a.checkElementsSorted(
+		pass,
+		metadata,
+		cfg.Prefix,
+		"test cases are not sorted",
+	)
+}
+
+// testCaseFieldIndex returns the index of the key field in the struct type of the
+// elements of a table-driven test literal, which is a slice or array of structs
+// (or pointers to structs) having that field.
+func testCaseFieldIndex(pass *analysis.Pass, lit *ast.CompositeLit, field string) (int, bool) {
+	elemType, ok := sliceElemType(pass, lit)
+	if !ok {
+		return 0, false
+	}
+
+	if pointer, ok := elemType.Underlying().(*types.Pointer); ok {
+		elemType = pointer.Elem()
+	}
+
+	structType, ok := elemType.Underlying().(*types.Struct)
+	if !ok {
+		return 0, false
+	}
+
+	for i := range structType.NumFields() {
+		if structType.Field(i).Name() == field {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// testCaseLit returns the composite literal of a table element, which is either
+// the element itself or its address.
+func testCaseLit(elt ast.Expr) *ast.CompositeLit {
+	if unary, ok := elt.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		elt = unary.X
+	}
+
+	lit, _ := elt.(*ast.CompositeLit)
+	return lit
+}
+
+// groupTestCases splits test cases into groups separated by empty lines.
+func groupTestCases(fset *token.FileSet, cases []testCase, ignoreGroups bool) [][]testCase {
+	var groups [][]testCase
+	for i, c := range cases {
+		if i == 0 || !ignoreGroups && fset.Position(c.start).Line-fset.Position(commentedEnd(cases[i-1])).Line > 1 {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], c)
+	}
+
+	return groups
+}
+
+func extractTestCase(pass *analysis.Pass, node testCase) (string, token.Pos, int) {
+	var key ast.Expr
+	for i, elt := range node.lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == node.field {
+				key = kv.Value
+				break
+			}
+		} else if i == node.fieldIndex {
+			key = elt
+			break
+		}
+	}
+
+	var value string
+	if key != nil {
+		if tv, ok := pass.TypesInfo.Types[key]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+			value = constant.StringVal(tv.Value)
+		} else {
+			var buf bytes.Buffer
+			_ = printer.Fprint(&buf, token.NewFileSet(), key)
+			value = buf.String()
+		}
+	}
+
+	pos := node.Pos()
+	line := pass.Fset.File(pos).Line(pos)
+	return value, pos, line
+}
+
+// generateTestCaseFix moves every test case of the group together with its comments.
+// Cases written on a single line, or sharing the first line with other code, are
+// handled like any other expression list.
+func (a *Analyzer) generateTestCaseFix(pass *analysis.Pass, original, sorted []Metadata) ([]byte, token.Pos, token.Pos) {
+	first, last := original[0].Node.(testCase), original[len(original)-1].Node.(testCase)
+	if pass.ReadFile == nil || pass.Fset.Position(first.start).Line == pass.Fset.Position(commentedEnd(last)).Line {
+		return a.generateExprFix(pass, original, sorted)
+	}
+
+	content, file := a.getFileContent(pass, first.start)
+	if content == nil || file == nil {
+		return nil, 0, 0
+	}
+
+	lineStart := file.Offset(file.LineStart(file.Line(first.start)))
+	if strings.TrimSpace(string(content[lineStart:file.Offset(first.start)])) != "" {
+		return a.generateExprFix(pass, original, sorted)
+	}
+
+	return a.generateCommentedFix(pass, original, sorted, ",")
+}
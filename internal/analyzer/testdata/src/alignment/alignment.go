@@ -0,0 +1,14 @@
+package alignment
+
+type Server struct {
+	Zed int
+	A string // want "struct fields are not sorted"
+
+	Zz int
+	Bb string // want "struct fields are not sorted"
+}
+
+const (
+	Zeta = "z" // last
+	Alpha = "a" // want "variable/constant declarations are not sorted"
+)
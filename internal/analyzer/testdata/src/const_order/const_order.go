@@ -0,0 +1,14 @@
+package const_order
+
+type Color int
+
+const (
+	Red   Color = iota // want Red:"const order 0"
+	Green              // want Green:"const order 1"
+	Blue               // want Blue:"const order 2"
+)
+
+// Untyped constants are no enum members
+const Max = 3
+
+const Black Color = -1 // want Black:"const order 3"
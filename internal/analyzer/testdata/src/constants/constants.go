@@ -16,4 +16,31 @@ const (
 const (
 	A1 = 1
 	// This comment is to keep line numbers stable
-)
\ No newline at end of file
+)
+type Kind int
+
+// Values come from iota, reordering would change them
+const (
+	KindB Kind = iota
+	KindA
+	KindC
+)
+
+// Values depend on iota even with every value written out
+const (
+	FlagZ = 1 << iota
+	FlagX = 1 << iota
+	FlagY = 1 << iota
+)
+
+// Values repeat the previous expression
+const (
+	Second = "s"
+	First
+)
+
+// Explicit values without iota are still checked
+const (
+	Beta  = 2
+	Alpha = 1 // want "constant declarations are not sorted"
+)
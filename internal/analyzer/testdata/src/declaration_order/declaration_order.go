@@ -0,0 +1,37 @@
+package declaration_order
+
+import "net/url"
+
+type Server struct {
+	Name    string
+	Address string
+	Port    int
+}
+
+// Keys in declaration order
+var sortedServer = Server{
+	Name:    "api",
+	Address: "localhost",
+	Port:    8080,
+}
+
+// Keys in alphabetical order
+var alphabeticalServer = Server{
+	Address: "localhost",
+	Name:    "api", // want "struct literal fields are not sorted"
+	Port:    8080,
+}
+
+// Struct declared in another package
+var sortedURL = url.URL{Scheme: "https", Host: "example.com", Path: "/"}
+
+var unsortedURL = url.URL{Host: "example.com", Scheme: "https"} // want "struct literal fields are not sorted"
+
+// Pointers to struct literals
+var pointerServer = &Server{Port: 8080, Name: "api"} // want "struct literal fields are not sorted"
+
+// Map literals are still sorted alphabetically
+var ports = map[string]int{
+	"https": 443,
+	"http":  80, // want "map literal keys are not sorted"
+}
@@ -0,0 +1,5 @@
+package base
+
+type Base struct{}
+
+type Generic[K comparable, V any] struct{}
@@ -0,0 +1,32 @@
+package first
+
+import (
+	"embedded/base"
+	"io"
+)
+
+type Local struct{}
+
+// Embedded fields go before named ones
+type Sorted struct {
+	*Local
+	base.Base
+	Alpha int
+	Zeta  int
+}
+
+type Unsorted struct {
+	Alpha  int
+	*Local // want "struct fields are not sorted"
+}
+
+type SortedReader interface {
+	io.Closer
+	io.Reader
+	Alpha()
+}
+
+type UnsortedReader interface {
+	Alpha()
+	io.Reader // want "interface methods are not sorted"
+}
@@ -0,0 +1,26 @@
+package inline
+
+import "embedded/base"
+
+type Local struct{}
+
+type Pair[K comparable, V any] struct{}
+
+// Embedded fields are sorted by their type name, without the pointer
+type SortedInline struct {
+	Alpha int
+	Local
+	*Pair[string, int]
+	Zeta int
+}
+
+type UnsortedInline struct {
+	*base.Base
+	base.Generic[string, int]
+	Alpha int // want "struct fields are not sorted"
+}
+
+type UnsortedGeneric struct {
+	Pair[string, int]
+	*Local // want "struct fields are not sorted"
+}
@@ -0,0 +1,83 @@
+package enum_order
+
+import "enums"
+
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+// Keys follow the declaration order of the constants
+var sortedLevels = map[enums.Level]string{
+	enums.Debug: "debug",
+	enums.Info:  "info",
+	enums.Warn:  "warn",
+	enums.Error: "error",
+}
+
+var unsortedLevels = map[enums.Level]string{
+	enums.Info:  "info",
+	enums.Debug: "debug", // want "map literal keys are not sorted"
+}
+
+var unsortedColors = map[Color]string{
+	Green: "green",
+	Red:   "red", // want "map literal keys are not sorted"
+}
+
+// Keys that are not ordered constants are sorted alphabetically
+var names = map[string]int{
+	"b": 2,
+	"a": 1, // want "map literal keys are not sorted"
+}
+
+func levelName(level enums.Level) string {
+	switch level {
+	case enums.Debug:
+		return "debug"
+	case enums.Info, enums.Warn:
+		return "info"
+	default:
+		return "error"
+	}
+}
+
+func colorName(color Color) string {
+	switch color {
+	case Blue:
+		return "blue"
+	case Red: // want "switch cases are not sorted"
+		return "red"
+	}
+
+	return ""
+}
+
+// The default clause keeps its place, cases around it are sorted separately
+func colorCode(color Color) int {
+	switch color {
+	case Green:
+		return 1
+	default:
+		return 0
+	case Red:
+		return 2
+	}
+}
+
+// Order of cases falling through is meaningful
+func shade(color Color) int {
+	n := 0
+	switch color {
+	case Blue:
+		n++
+		fallthrough
+	case Red:
+		n++
+	}
+
+	return n
+}
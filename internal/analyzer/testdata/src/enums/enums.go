@@ -0,0 +1,10 @@
+package enums
+
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
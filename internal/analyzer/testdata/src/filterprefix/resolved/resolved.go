@@ -0,0 +1,22 @@
+package resolved
+
+const (
+	PrefA = "a"
+	PrefB = "b"
+)
+
+const (
+	Second = "Pref2"
+	First  = "Pref1"
+)
+
+// Prefixes match the constant names, not their values
+var byName = map[string]int{
+	PrefB: 1,
+	PrefA: 2, // want "map literal keys are not sorted"
+}
+
+var byValue = map[string]int{
+	Second: 1,
+	First:  2,
+}
@@ -0,0 +1,30 @@
+package anchors
+
+import "sync"
+
+type noCopy struct{}
+
+// Fields guarded by a mutex stay below it
+type Cache struct {
+	name string
+	id   int // want "struct fields are not sorted"
+	zone string
+
+	mu      sync.Mutex
+	values  map[string]int
+	expires map[string]int // want "struct fields are not sorted"
+	hits    int
+
+	lock  *sync.RWMutex
+	queue []string
+}
+
+// Marker fields stay in place
+type Handle struct {
+	noCopy noCopy
+	path   string
+	fd     int // want "struct fields are not sorted"
+	mode   uint32
+	_      [0]func()
+	b, a   bool // want "struct fields are not sorted"
+}
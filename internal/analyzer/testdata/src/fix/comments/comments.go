@@ -0,0 +1,44 @@
+package comments
+
+// Comment lines split groups, the comment above the first element of a group is
+// its header and stays in place
+const (
+	// Letters
+	Zeta  = 3 // last letter
+	Alpha = 1 // want "variable/constant declarations are not sorted"
+	Beta  = 2 // second letter
+)
+
+type Server struct {
+	// Network
+	Port int    // tcp port
+	Host string // want "struct fields are not sorted"
+	Addr string // resolved
+}
+
+var limits = map[string]int{ // by endpoint
+	// Transfers
+	"upload":   100,
+	"download": 10, // want "map literal keys are not sorted"
+	"delete":   1,  // rarely used
+}
+
+const (
+	// HTTP codes
+	OK       = 200
+	NotFound = 404 // want "variable/constant declarations are not sorted"
+	// gRPC codes
+	Unknown  = 2
+	Canceled = 1 // want "variable/constant declarations are not sorted"
+)
+
+// Each doc comment starts a group, so documented elements keep their comments and
+// are left as written
+type Client struct {
+	// Timeout of a request
+	Timeout int
+	// Retries of a failed request
+	Retries int
+	// BaseURL of the API
+	BaseURL string
+}
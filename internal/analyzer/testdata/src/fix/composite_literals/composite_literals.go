@@ -11,7 +11,7 @@ type Person struct {
 func main() {
 	// Multi-line struct literal with unsorted fields
 	p1 := &Person{
-		Street:  "Main St", // want `composite literal elements are not sorted`
+		Street:  "Main St", // want `struct literal fields are not sorted`
 		City:    "New York",
 		Country: "USA",
 		Age:     30,
@@ -20,7 +20,7 @@ func main() {
 
 	// Another multi-line struct literal
 	p2 := Person{
-		Country: "Canada", // want `composite literal elements are not sorted`
+		Country: "Canada", // want `struct literal fields are not sorted`
 		City:    "Toronto",
 		Street:  "Queen St",
 		Name:    "Jane",
@@ -28,11 +28,11 @@ func main() {
 	}
 
 	// Single-line struct literal (should remain single-line)
-	p3 := Person{Country: "UK", City: "London", Age: 35, Name: "Bob", Street: "Baker St"} // want `composite literal elements are not sorted`
+	p3 := Person{Country: "UK", City: "London", Age: 35, Name: "Bob", Street: "Baker St"} // want `struct literal fields are not sorted`
 
 	// Map literal with multi-line format
 	m := map[string]int{
-		"zebra": 1, // want `composite literal elements are not sorted`
+		"zebra": 1, // want `map literal keys are not sorted`
 		"apple": 2,
 		"mango": 3,
 		"banana": 4,
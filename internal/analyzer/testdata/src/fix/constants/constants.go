@@ -10,4 +10,13 @@ const (
 	Z = "z"
 	X = "x" // want "variable/constant declarations are not sorted"
 	Y = "y"
-)
\ No newline at end of file
+)
+
+type Kind int
+
+// Left as is, values come from iota
+const (
+	KindB Kind = iota
+	KindA
+	KindC
+)
@@ -0,0 +1,13 @@
+package declaration_order
+
+import "net/url"
+
+type Server struct {
+	Name    string
+	Address string
+	Port    int
+}
+
+var server = Server{Port: 8080, Address: "localhost", Name: "api"} // want "struct literal fields are not sorted"
+
+var link = url.URL{Path: "/", Host: "example.com", Scheme: "https"} // want "struct literal fields are not sorted"
@@ -0,0 +1,9 @@
+package embedded
+
+import "embedded/base"
+
+type Server struct {
+	Name       string
+	*base.Base // want "struct fields are not sorted"
+	Addr       string
+}
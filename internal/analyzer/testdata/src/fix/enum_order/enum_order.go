@@ -0,0 +1,20 @@
+package enum_order
+
+import "enums"
+
+var levels = map[enums.Level]string{enums.Error: "error", enums.Debug: "debug", enums.Warn: "warn"} // want "map literal keys are not sorted"
+
+func levelName(level enums.Level) string {
+	switch level {
+	// Errors are reported
+	case enums.Error:
+		return "error"
+	case enums.Debug: // want "switch cases are not sorted"
+		return "debug"
+	case enums.Warn, enums.Info:
+		// Same treatment
+		return "warning" // not an error
+	}
+
+	return ""
+}
@@ -0,0 +1,13 @@
+package app
+
+import (
+	"imports/util" // helpers
+	"fmt"          // want `import "fmt" is misplaced, expected in std section`
+
+	// lib is our shared library
+	acme "github.com/acme/lib" // want `import "github.com/acme/lib" is misplaced, expected in prefix\(github.com/acme\) section`
+	"github.com/other/kit"     // want `import "github.com/other/kit" is misplaced, expected in third-party section`
+	"strings"                  // want `import "strings" is misplaced, expected in std section`
+)
+
+var _ = fmt.Sprint(strings.ToUpper(kit.Tool()), acme.Hello(), util.Help())
@@ -0,0 +1,64 @@
+package multiline
+
+type Config struct {
+	Server struct {
+		Host string
+		Port int
+	}
+	Limits struct { // want "struct fields are not sorted"
+		Burst int
+		Rate  int
+	}
+	Hook func(
+		name string,
+		code int,
+	) error
+	Debug bool
+}
+
+type Store interface {
+	Save(
+		key string,
+	) error
+	Load(key string) error // want "interface methods are not sorted"
+}
+
+var routes = map[string]map[string]int{
+	"users": {
+		"get": 1,
+		"put": 2,
+	},
+	"admin": { // want "map literal keys are not sorted"
+		"get": 3,
+	},
+}
+
+var (
+	zones = []string{
+		"eu",
+		"us",
+	}
+	regions = map[string]int{ // want "variable/constant declarations are not sorted"
+		"eu": 1,
+	}
+)
+
+// Fields of nested structs are sorted along with the outer ones
+type Nested struct {
+	Zone struct {
+		Name string
+		ID   int // want "struct fields are not sorted"
+	}
+	Area int // want "struct fields are not sorted"
+}
+
+var grants = map[string]map[string]bool{
+	"users": {
+		"write": false,
+		"read":  true, // want "map literal keys are not sorted"
+	},
+	"admin": { // want "map literal keys are not sorted"
+		"write": true,
+		"read":  true, // want "map literal keys are not sorted"
+	},
+}
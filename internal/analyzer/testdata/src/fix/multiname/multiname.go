@@ -8,4 +8,20 @@ var (
 const (
 	Z, X, Y = 3, 1, 2
 	C, A, B = "c", "a", "b" // want "variable/constant declarations are not sorted"
-)
\ No newline at end of file
+)
+
+var q, p = "q", "p" // want "variable/constant declarations are not sorted"
+
+func pair() (int, int) { return 1, 2 }
+
+// Names take the results of pair in order and are left as written
+var (
+	t    = 0
+	s, r = pair() // want "declarations are not sorted$" "names left unsorted: their values come from a single multi-value expression"
+	u    = 1
+)
+
+var (
+	low           = 0
+	width, height = pair() // want "names left unsorted"
+)
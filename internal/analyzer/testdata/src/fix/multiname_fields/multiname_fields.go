@@ -0,0 +1,32 @@
+package multiname_fields
+
+type Names struct {
+	B, A int // want "struct fields are not sorted"
+	C    string
+}
+
+type Lines struct {
+	Z    string
+	Y, B int // want "struct fields are not sorted"
+	X    bool
+}
+
+var (
+	second, first int // want "variable/constant declarations are not sorted"
+)
+
+// Names keep the separators they are written with
+type Compact struct {
+	D,C int
+	A   int // want "struct fields are not sorted"
+}
+
+type Split struct {
+	A int
+	C, // want "struct fields are not sorted"
+		B string
+}
+
+var (
+	f,e = 2, 1 // want "variable/constant declarations are not sorted"
+)
@@ -0,0 +1,8 @@
+package numeric_keys
+
+var codes = map[int]string{10: "ten", 0x1F: "thirty one", -1: "minus one", 9: "nine"} // want "map literal keys are not sorted"
+
+var shifts = [...]string{1 << 3: "eight", 1 << 1: "two", 1: "one"} // want "indexed array elements are not sorted"
+
+// Numeric keys go before the others
+var mixed = map[any]string{"b": "b", 10: "ten", "a": "a", 9: "nine"} // want "map literal keys are not sorted"
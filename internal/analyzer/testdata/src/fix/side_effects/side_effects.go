@@ -0,0 +1,37 @@
+package side_effects
+
+type Config struct {
+	A, B string
+}
+
+func mustLoad(name string) string { return name }
+
+// Loaded in source order, left as is
+var config = Config{
+	B: mustLoad("b"),
+	A: mustLoad("a"), // want "struct literal fields are not sorted"
+}
+
+// Initialized in source order, left as is
+var (
+	second = mustLoad("second")
+	first  = mustLoad("first") // want "variable/constant declarations are not sorted"
+)
+
+func receive(ch chan string) map[string]string {
+	// Received in source order, left as is
+	return map[string]string{"z": <-ch, "a": <-ch} // want "map literal keys are not sorted"
+}
+
+type ID string
+
+// Conversions, builtins and function literals have no side effects
+var pure = map[string]any{"z": ID("z"), "m": len("m"), "a": func() string { return mustLoad("a") }} // want "map literal keys are not sorted"
+
+// Loaded in source order, names left as written
+var beta, alpha = mustLoad("beta"), mustLoad("alpha") // want "names left unsorted: their values have side effects"
+
+func locals() (string, int) {
+	var d, c = mustLoad("d"), 2 // want "names left unsorted: their values have side effects"
+	return d, c
+}
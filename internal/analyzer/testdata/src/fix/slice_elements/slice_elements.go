@@ -0,0 +1,17 @@
+package slice_elements
+
+type Kind int
+
+const (
+	KindA Kind = iota
+	KindB
+	KindC
+)
+
+var kinds = []Kind{KindC, KindA, KindB} // want "slice elements are not sorted"
+
+var allowedHosts = []string{
+	"example.org",
+	"example.com", // want "slice elements are not sorted"
+	"example.net",
+}
@@ -0,0 +1,7 @@
+package struct_tags
+
+type User struct {
+	ID    int    `db:"id" json:"id,omitempty"`                         // want "struct tag keys are not sorted"
+	Email string `validate:"required,email" yaml:"email" json:"email"` // want "struct tag keys are not sorted"
+	Name  string "zeta:\"z\"  json:\"name\""                           // want "struct tag keys are not sorted"
+}
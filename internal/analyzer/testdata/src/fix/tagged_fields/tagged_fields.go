@@ -0,0 +1,12 @@
+package tagged_fields
+
+// Tags are sorted along with the fields, keeping their spacing
+type User struct {
+	Name string `yaml:"name"  json:"name"` // want "struct tag keys are not sorted"
+	ID   int    `yaml:"id" json:"id"`      // want "struct fields are not sorted" "struct tag keys are not sorted"
+}
+
+type Pair struct {
+	B int `yaml:"v" json:"v"` // want "struct tag keys are not sorted"
+	A int `yaml:"v" json:"v"` // want "struct fields are not sorted" "struct tag keys are not sorted"
+}
@@ -0,0 +1,7 @@
+package test_cases
+
+type testCase struct {
+	input string
+	name  string
+	want  int
+}
@@ -0,0 +1,42 @@
+package test_cases
+
+const nameLetters = "letters"
+
+var inlineCases = []testCase{
+	{input: "abc", name: "letters", want: 3},
+	{input: "", name: "empty", want: 0}, // want "test cases are not sorted"
+}
+
+var multilineCases = []*testCase{
+	// Letters are counted
+	{
+		input: "abc",
+		name:  nameLetters,
+		want:  3,
+	}, // three letters
+	{ // want "test cases are not sorted"
+		input: "",
+		name:  "empty",
+	},
+	&testCase{
+		input: "1",
+		name:  "digit",
+		want:  1,
+	},
+}
+
+var positionalCases = []testCase{
+	{"abc", "letters", 3},
+	{"", "empty", 0},  // want "test cases are not sorted"
+	{"1", "digit", 1}, // one digit
+}
+
+var sharedLineCases = []testCase{{input: "b", name: "b",
+	want: 2},
+	{input: "a", name: "a", want: 1}, // want "test cases are not sorted"
+}
+
+var unsortedKeyCases = []testCase{
+	{name: "b", want: 2, input: "bb"}, // want "struct literal fields are not sorted"
+	{name: "a", want: 1, input: "a"},  // want "test cases are not sorted" "struct literal fields are not sorted"
+}
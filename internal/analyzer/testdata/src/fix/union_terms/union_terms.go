@@ -0,0 +1,16 @@
+package union_terms
+
+type Number interface {
+	~int64 | int | ~float64 // want "union terms are not sorted"
+}
+
+type Text interface {
+	~string | []byte // want "union terms are not sorted"
+}
+
+type Integer interface {
+	~uint64 | // unsigned
+		~int | // want "union terms are not sorted"
+		// Bytes are small
+		~uint8
+}
@@ -10,4 +10,56 @@ var (
 	z int
 	x int // want "variable/constant declarations are not sorted"
 	y int
-)
\ No newline at end of file
+)
+
+func local() int {
+	// Left as is, a uses b
+	var (
+		b = 1
+		a = b + 1 // want "variable/constant declarations are not sorted"
+	)
+
+	return a
+}
+
+func shadowed() int {
+	// Left as is, y uses the package-level x
+	var (
+		y = x
+		x = 2 // want "variable/constant declarations are not sorted"
+	)
+
+	return x + y
+}
+
+func independent() int {
+	var (
+		d = 4
+		c = 3 // want "variable/constant declarations are not sorted"
+		e = d
+	)
+
+	return c + e
+}
+
+type point struct{ b int }
+
+func keyed() int {
+	// Sorted, the key b names a field rather than the local b
+	var (
+		p = point{b: 1}
+		b = 2 // want "variable/constant declarations are not sorted"
+	)
+
+	return p.b + b
+}
+
+func literal() int {
+	// Sorted, e in the function literal is its own parameter
+	var (
+		f = func(e int) int { return e * 2 }
+		e = 3 // want "variable/constant declarations are not sorted"
+	)
+
+	return f(e)
+}
@@ -0,0 +1,15 @@
+package variadic_options
+
+type Option func()
+
+func New(opts ...Option) {}
+
+func WithAddr(addr string) Option { return nil }
+
+func WithLogger(name string) Option { return nil }
+
+func WithTimeout(timeout int) Option { return nil }
+
+func main() {
+	New(WithTimeout(5), WithAddr(":90"), WithLogger("l"), WithAddr(":80")) // want "variadic arguments are not sorted"
+}
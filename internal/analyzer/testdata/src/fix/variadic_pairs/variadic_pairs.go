@@ -0,0 +1,11 @@
+package variadic_pairs
+
+import "log/slog"
+
+func log(logger *slog.Logger, u string, n int) {
+	slog.Info("msg", "user", u, "attempt", n, slog.Bool("admin", true)) // want "variadic key/value pairs are not sorted"
+	logger.Error("msg",
+		"user", u,
+		"attempt", n, // want "variadic key/value pairs are not sorted"
+	)
+}
@@ -0,0 +1,3 @@
+package kit
+
+func Tool() string { return "tool" }
@@ -0,0 +1,3 @@
+package tools
+
+func Run() string { return "run" }
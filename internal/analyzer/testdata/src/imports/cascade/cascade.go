@@ -0,0 +1,13 @@
+package cascade
+
+// Only the module import is misplaced, the groups following it are in order
+import (
+	"imports/util" // want `import "imports/util" is misplaced, expected in module section`
+
+	"fmt"
+	"strings"
+
+	"github.com/other/kit"
+)
+
+var _ = fmt.Sprint(strings.ToUpper(kit.Tool()), util.Help())
@@ -0,0 +1,13 @@
+package cascade
+
+// A third-party import in the std group does not make the third-party group misplaced
+import (
+	"fmt"
+	"github.com/other/kit" // want `import "github.com/other/kit" is misplaced, expected in third-party section`
+
+	"github.com/other/tools"
+
+	"imports/util"
+)
+
+var _ = fmt.Sprint(kit.Tool(), tools.Run(), util.Help())
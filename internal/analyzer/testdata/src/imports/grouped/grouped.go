@@ -0,0 +1,14 @@
+package grouped
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/other/kit"
+
+	"github.com/acme/lib"
+
+	"imports/util"
+)
+
+var _ = fmt.Sprint(strings.ToUpper(kit.Tool()), lib.Hello(), util.Help())
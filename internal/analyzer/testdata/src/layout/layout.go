@@ -0,0 +1,65 @@
+package layout
+
+import (
+	"bytes"
+	"encoding/binary"
+	"structs"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Encoded field by field in declaration order
+type Header struct {
+	Version uint8
+	Length  uint16
+}
+
+// Mirrors a platform layout
+type HostStat struct {
+	_    structs.HostLayout
+	Size int64
+	Mode uint32
+}
+
+// Field offsets are computed
+type Record struct {
+	Name string
+	Id   int
+}
+
+// Counter must stay first for 64-bit alignment on 32-bit platforms
+type Stats struct {
+	Hits  int64
+	Count int32
+}
+
+// Positional literals rely on field order
+type Point struct {
+	Y int
+	X int
+}
+
+// Nothing relies on field order
+type Plain struct {
+	B int
+	A int // want "struct fields are not sorted"
+}
+
+// Only written to, its own fields are not encoded
+type Sink struct {
+	Written int
+	Closed  bool // want "struct fields are not sorted"
+}
+
+func (s *Sink) Write(p []byte) (int, error) {
+	s.Written += len(p)
+	return len(p), nil
+}
+
+func use(buf *bytes.Buffer, stats *Stats) uintptr {
+	_ = binary.Write(buf, binary.LittleEndian, []Header{{Version: 1}})
+	_ = binary.Write(&Sink{}, binary.LittleEndian, uint32(1))
+	atomic.AddInt64(&stats.Hits, 1)
+	_ = Point{1, 2}
+	return unsafe.Offsetof(Record{}.Id)
+}
@@ -0,0 +1,28 @@
+package constants
+
+import "net/http"
+
+type Status int
+
+const (
+	StatusPending Status = 1
+	StatusActive  Status = 2
+)
+
+// Constant keys are compared by their values
+var sortedStatuses = map[Status]string{
+	StatusPending: "pending",
+	StatusActive:  "active",
+}
+
+var unsortedStatuses = map[Status]string{
+	StatusActive:  "active",
+	StatusPending: "pending", // want "map literal keys are not sorted"
+}
+
+// Constants of other packages are resolved too, "DELETE" < "GET" < "POST"
+var unsortedMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodDelete: false, // want "map literal keys are not sorted"
+	"POST":            false,
+}
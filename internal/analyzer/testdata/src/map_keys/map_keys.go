@@ -1,5 +1,10 @@
 package map_keys
 
+import (
+	"net/http"
+	"time"
+)
+
 // Sorted string keys
 var sortedStringMap = map[string]int{
 	"a": 1,
@@ -10,7 +15,7 @@ var sortedStringMap = map[string]int{
 // Unsorted string keys
 var unsortedStringMap = map[string]int{
 	"c": 3,
-	"a": 1, // want "composite literal elements are not sorted"
+	"a": 1, // want "map literal keys are not sorted"
 	"b": 2,
 }
 
@@ -24,7 +29,7 @@ var sortedIntMap = map[int]string{
 // Unsorted int keys
 var unsortedIntMap = map[int]string{
 	3: "c",
-	1: "a", // want "composite literal elements are not sorted"
+	1: "a", // want "map literal keys are not sorted"
 	2: "b",
 }
 
@@ -40,11 +45,11 @@ var mapWithGroups = map[string]int{
 // Unsorted map with groups
 var unsortedMapWithGroups = map[string]int{
 	"b": 2,
-	"a": 1, // want "composite literal elements are not sorted"
+	"a": 1, // want "map literal keys are not sorted"
 
 	// Empty line resets sorting with default config
 	"d": 4,
-	"c": 3, // want "composite literal elements are not sorted"
+	"c": 3, // want "map literal keys are not sorted"
 }
 
 // Non-sortable key type (not checked)
@@ -52,3 +57,62 @@ var mapWithNonSortableKeys = map[complex64]int{
 	1 + 2i: 1,
 	0 + 1i: 2,
 }
+
+// Int keys are compared by value, not by text
+var sortedNumericMap = map[int]string{
+	9:  "nine",
+	10: "ten",
+}
+
+var unsortedNumericMap = map[int]string{
+	10: "ten",
+	9:  "nine", // want "map literal keys are not sorted"
+}
+
+// Hex, negative and computed keys
+var sortedExprMap = map[int]string{
+	-1:     "minus one",
+	0x1F:   "thirty one",
+	1 << 6: "sixty four",
+}
+
+var unsortedExprMap = map[int]string{
+	1 << 3: "eight",
+	-2:     "minus two", // want "map literal keys are not sorted"
+}
+
+// Float and rune keys
+var unsortedFloatMap = map[float64]string{0.5: "half", -0.25: "minus quarter"} // want "map literal keys are not sorted"
+
+var unsortedRuneMap = map[rune]string{'b': "b", 'a': "a"} // want "map literal keys are not sorted"
+
+// Array indexes
+var sortedIndexes = [...]string{2: "b", 10: "j"}
+
+var unsortedIndexes = [...]string{2: "b", 1: "a"} // want "indexed array elements are not sorted"
+
+type Point struct {
+	X, Y int
+}
+
+// Selector keys are compared by their text
+var sortedMethods = map[string]bool{
+	http.MethodGet:  true,
+	http.MethodPost: false,
+}
+
+var unsortedMethods = map[string]bool{
+	http.MethodPost: false,
+	http.MethodGet:  true, // want "map literal keys are not sorted"
+}
+
+// Composite and call keys are compared by their canonical rendering
+var unsortedPoints = map[Point]string{
+	{X: 2, Y: 1}:  "b",
+	{X: 1, Y: 10}: "a", // want "map literal keys are not sorted"
+}
+
+var unsortedDurations = map[time.Duration]string{
+	time.Duration(3): "c",
+	time.Duration(1): "a", // want "map literal keys are not sorted"
+}
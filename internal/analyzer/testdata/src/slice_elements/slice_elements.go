@@ -0,0 +1,43 @@
+package slice_elements
+
+type Kind string
+
+const (
+	KindA Kind = "a"
+	KindB Kind = "b"
+	KindC Kind = "c"
+)
+
+// Sorted set of kinds
+var sortedKinds = []Kind{KindA, KindB, KindC}
+
+// Unsorted set of kinds
+var unsortedKinds = []Kind{KindB, KindA, KindC} // want "slice elements are not sorted"
+
+// Unsorted array of kinds
+var unsortedKindsArray = [...]Kind{KindC, KindA} // want "slice elements are not sorted"
+
+// Unsorted allowlist matched by variable name
+var allowedHosts = []string{
+	"example.org",
+	"example.com", // want "slice elements are not sorted"
+}
+
+// Unsorted strings not matched by any pattern (order matters)
+var steps = []string{"prepare", "build", "deploy"}
+
+// Elements that are not literals or identifiers are not checked
+var computedKinds = []Kind{Kind("b"), KindA}
+
+type Policy struct {
+	allowedMethods []string
+}
+
+func newPolicy() Policy {
+	allowedUsers := []string{"bob", "alice"} // want "slice elements are not sorted"
+	_ = allowedUsers
+
+	return Policy{
+		allowedMethods: []string{"POST", "GET"}, // want "slice elements are not sorted"
+	}
+}
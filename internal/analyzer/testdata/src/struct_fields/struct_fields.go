@@ -74,6 +74,28 @@ var sortedStruct = SortedStruct{
 func foo() {
 	_ = SortedStruct{
 		B: 2,
-		A: 1, // want "composite literal elements are not sorted"
+		A: 1, // want "struct literal fields are not sorted"
 	}
 }
+
+// Names within a multi-name field are sorted
+type SortedNames struct {
+	A, B int
+	C    string
+}
+
+type UnsortedNames struct {
+	B, A int // want "struct fields are not sorted"
+	C    string
+}
+
+// Multi-name fields are ordered by their first name after sorting
+type UnsortedMultiNameFields struct {
+	Z    string
+	Y, B int // want "struct fields are not sorted"
+}
+
+// Function-typed fields
+type Handlers struct {
+	onStop, onStart func() // want "struct fields are not sorted"
+}
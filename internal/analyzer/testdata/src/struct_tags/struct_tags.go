@@ -0,0 +1,25 @@
+package struct_tags
+
+// Tag keys in configured order
+type Sorted struct {
+	ID   int    `json:"id" yaml:"id" db:"id" validate:"required"`
+	Name string `json:"name" db:"name"`
+	Note string `json:"note" custom:"a" other:"b"`
+}
+
+// Tag keys out of configured order
+type Unsorted struct {
+	ID   int    `db:"id" json:"id"`                 // want "struct tag keys are not sorted"
+	Name string `validate:"required" json:"name"`   // want "struct tag keys are not sorted"
+	Note string `json:"note" other:"b" custom:"a"` // want "struct tag keys are not sorted"
+}
+
+// Interpreted string literal tags are checked too
+type Interpreted struct {
+	ID int "yaml:\"id\" json:\"id\"" // want "struct tag keys are not sorted"
+}
+
+// Malformed tags are not checked
+type Malformed struct {
+	ID int `yaml:id json:"id"`
+}
@@ -0,0 +1,13 @@
+package test_cases
+
+type testCase struct {
+	input string
+	name  string
+	want  int
+}
+
+// Tables outside of test files are not checked
+var productionCases = []testCase{
+	{input: "abc", name: "letters", want: 3},
+	{input: "", name: "empty", want: 0},
+}
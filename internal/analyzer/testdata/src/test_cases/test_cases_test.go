@@ -0,0 +1,54 @@
+package test_cases
+
+// Sorted test cases
+var sortedCases = []testCase{
+	{input: "", name: "empty", want: 0},
+	{input: "abc", name: "letters", want: 3},
+}
+
+// Unsorted test cases
+var unsortedCases = []testCase{
+	{input: "abc", name: "letters", want: 3},
+	{input: "", name: "empty", want: 0}, // want "test cases are not sorted"
+}
+
+// Unsorted multi-line test cases with pointers
+var pointerCases = []*testCase{
+	{
+		input: "abc",
+		name:  "letters",
+	},
+	{ // want "test cases are not sorted"
+		input: "",
+		name:  "empty",
+	},
+}
+
+// Unsorted positional test cases
+var positionalCases = []testCase{
+	{"abc", "letters", 3},
+	{"", "empty", 0}, // want "test cases are not sorted"
+}
+
+// Groups separated by empty lines are checked independently
+var groupedCases = []testCase{
+	{
+		input: "b",
+		name:  "b",
+	},
+
+	{
+		input: "a",
+		name:  "a",
+	},
+}
+
+type named struct {
+	title string
+}
+
+// Elements without the key field are not checked
+var otherCases = []named{
+	{title: "b"},
+	{title: "a"},
+}
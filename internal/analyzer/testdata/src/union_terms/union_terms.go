@@ -0,0 +1,33 @@
+package union_terms
+
+// Sorted union
+type Integer interface {
+	~int | ~int16 | ~int32 | ~int64 | ~int8
+}
+
+// Unsorted union, tilde is ignored for comparison
+type Number interface {
+	~int64 | int | ~float64 // want "union terms are not sorted"
+}
+
+// Each union line is a group of its own
+type Mixed interface {
+	~string | ~byte // want "union terms are not sorted"
+	fmtStringer
+}
+
+type fmtStringer interface {
+	String() string
+}
+
+// Union spanning multiple lines
+type Ordered interface {
+	~uint |
+		~float32 | // want "union terms are not sorted"
+		~string
+}
+
+// Unions of composite types
+type Slices interface {
+	[]string | []byte // want "union terms are not sorted"
+}
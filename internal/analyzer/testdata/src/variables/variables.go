@@ -17,3 +17,8 @@ var (
 	A1 = 1
 	// This comment is to keep line numbers stable
 )
+
+// Names within a multi-name declaration are sorted
+var (
+	multiB, multiA int // want "variable/constant declarations are not sorted"
+)
@@ -0,0 +1,29 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+)
+
+type Router struct{}
+
+func (r *Router) Use(middlewares ...string) {}
+
+func (r *Router) Handle(path string, handlers ...string) {}
+
+func allowed(r *Router, errA, errB error) {
+	_ = errors.Join(errB, errA) // want "variadic arguments are not sorted"
+	r.Use("recover", "auth")    // want "variadic arguments are not sorted"
+	fmt.Print("b", "a")         // want "variadic arguments are not sorted"
+}
+
+func notAllowed() {
+	_ = filepath.Join("b", "a")
+}
+
+func excluded(r *Router) {
+	r.Handle("/", "b", "a")
+	fmt.Println("b", "a")
+	_ = append([]string{}, "b", "a")
+}
@@ -0,0 +1,35 @@
+package options
+
+import "time"
+
+type Server struct{}
+
+type Option func(*Server)
+
+func New(opts ...Option) *Server { return &Server{} }
+
+func WithAddr(addr string) Option { return nil }
+
+func WithLogger(name string) Option { return nil }
+
+func WithTimeout(timeout time.Duration) Option { return nil }
+
+func WithValue[T any](value T) Option { return nil }
+
+func Apply(opts ...Option) {}
+
+func sorted() {
+	New(WithAddr(":80"), WithLogger("z"), WithTimeout(5))
+	New(WithTimeout(5), WithValue[int](1))
+}
+
+func unsorted() {
+	New(WithTimeout(5), WithAddr(":80"), WithLogger("a")) // want "variadic arguments are not sorted"
+	New(WithValue("b"), WithAddr(":80"))                  // want "variadic arguments are not sorted"
+}
+
+func notMatched() {
+	// Apply does not match any options pattern, so whole expressions are compared
+	Apply(WithAddr(":90"), WithAddr(":80")) // want "variadic arguments are not sorted"
+	New(WithAddr(":90"), WithAddr(":80"))
+}
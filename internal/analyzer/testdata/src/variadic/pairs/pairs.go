@@ -0,0 +1,39 @@
+package pairs
+
+import (
+	"context"
+	"log/slog"
+)
+
+const keyUser = "user"
+
+func sortedPairs(logger *slog.Logger, u string, n int) {
+	slog.Info("msg", "attempt", n, "user", u)
+	logger.Info("msg", "attempt", n, keyUser, u)
+	logger.With("attempt", n, "user", u)
+}
+
+func unsortedPairs(logger *slog.Logger, u string, n int) {
+	slog.Info("msg", "user", u, "attempt", n)                                      // want "variadic key/value pairs are not sorted"
+	logger.Warn("msg", keyUser, u, "attempt", n)                                   // want "variadic key/value pairs are not sorted"
+	logger.With("user", u, "attempt", n)                                           // want "variadic key/value pairs are not sorted"
+	slog.Log(context.Background(), slog.LevelInfo, "msg", "user", u, "attempt", n) // want "variadic key/value pairs are not sorted"
+}
+
+func attrs(u string, n int) {
+	slog.Info("msg", slog.Int("attempt", n), "user", u)
+	slog.Info("msg", slog.String("user", u), "attempt", n) // want "variadic key/value pairs are not sorted"
+}
+
+func multiLine(u string, n int) {
+	slog.Info("msg",
+		"user", u,
+		"attempt", n, // want "variadic key/value pairs are not sorted"
+	)
+}
+
+func unknownKeys(attr slog.Attr, u string, n int) {
+	// Keys that are not strings or attributes make the pairs unknown
+	slog.Info("msg", "user", u, n, "attempt")
+	slog.Info("msg", "user", u, "attempt")
+}
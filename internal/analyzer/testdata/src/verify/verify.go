@@ -0,0 +1,8 @@
+package verify
+
+var (
+	b = 2
+	a = 1
+)
+
+var sum = a + b
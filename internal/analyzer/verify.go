@@ -0,0 +1,101 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+var (
+	errFixDoesNotParse     = errors.New("fixed code does not parse")
+	errFixDoesNotTypeCheck = errors.New("fixed code does not type-check")
+)
+
+// verifyFix applies the fix to a copy of its file and checks that the file still
+// parses and, when type information is available, that the package still
+// type-checks. Fixes that cannot be verified, like those of files that cannot be
+// read, are assumed to be valid.
+func (a *Analyzer) verifyFix(pass *analysis.Pass, fix *FixSuggestion) error {
+	if pass.ReadFile == nil {
+		return nil
+	}
+
+	content, file := a.getFileContent(pass, fix.From)
+	if content == nil || file == nil {
+		return nil
+	}
+
+	fixed := make([]byte, 0, len(content)+len(fix.Replacement))
+	fixed = append(fixed, content[:file.Offset(fix.From)]...)
+	fixed = append(fixed, fix.Replacement...)
+	fixed = append(fixed, content[file.Offset(fix.To):]...)
+
+	fset := token.NewFileSet()
+	fixedFile, err := parser.ParseFile(fset, file.Name(), fixed, parser.SkipObjectResolution)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errFixDoesNotParse, err)
+	}
+
+	if pass.TypesInfo == nil || pass.Pkg == nil {
+		return nil
+	}
+
+	// Other files of the package are parsed again, they must share the file set
+	files := []*ast.File{fixedFile}
+	for _, f := range pass.Files {
+		name := pass.Fset.File(f.FileStart).Name()
+		if name == file.Name() {
+			continue
+		}
+
+		src, err := pass.ReadFile(name)
+		if err != nil {
+			return nil
+		}
+
+		parsed, err := parser.ParseFile(fset, name, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil
+		}
+		files = append(files, parsed)
+	}
+
+	cfg := types.Config{
+		GoVersion: pass.Pkg.GoVersion(),
+		Importer:  packageImporter(pass.Pkg.Imports()),
+		Sizes:     pass.TypesSizes,
+	}
+	if _, err := cfg.Check(pass.Pkg.Path(), fset, files, nil); err != nil {
+		return fmt.Errorf("%w: %w", errFixDoesNotTypeCheck, err)
+	}
+
+	return nil
+}
+
+// packageImporter imports the already type-checked dependencies of a package.
+type packageImporter []*types.Package
+
+func (imports packageImporter) Import(path string) (*types.Package, error) {
+	for _, pkg := range imports {
+		if pkg.Path() == path {
+			return pkg, nil
+		}
+	}
+
+	return nil, fmt.Errorf("package %q is not imported", path)
+}
+
+// droppedFixNote explains in the diagnostic message why its fix was dropped.
+func droppedFixNote(err error) string {
+	reason := errFixDoesNotTypeCheck
+	if errors.Is(err, errFixDoesNotParse) {
+		reason = errFixDoesNotParse
+	}
+
+	return " (suggested fix dropped: " + reason.Error() + ")"
+}
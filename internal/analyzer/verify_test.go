@@ -0,0 +1,38 @@
+package analyzer
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestVerifyFix(t *testing.T) {
+	a := New()
+	errs := make(map[string]error)
+
+	verifier := &analysis.Analyzer{
+		Name: "verify",
+		Doc:  "applies fixes to the first var block",
+		Run: func(pass *analysis.Pass) (any, error) {
+			spec := pass.Files[0].Decls[0].(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+			fix := func(replacement string) error {
+				return a.verifyFix(pass, &FixSuggestion{From: spec.Pos(), Replacement: []byte(replacement), To: spec.End()})
+			}
+
+			errs["valid"] = fix("b = 3")
+			errs["parse"] = fix("b =")
+			errs["type-check"] = fix(`b = "2"`)
+			return nil, nil
+		},
+	}
+
+	analysistest.Run(t, analysistest.TestData(), verifier, "verify")
+
+	require.NoError(t, errs["valid"])
+	require.ErrorIs(t, errs["parse"], errFixDoesNotParse)
+	require.ErrorIs(t, errs["type-check"], errFixDoesNotTypeCheck)
+	require.Equal(t, " (suggested fix dropped: fixed code does not parse)", droppedFixNote(errs["parse"]))
+}
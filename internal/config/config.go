@@ -7,47 +7,254 @@ type CheckConfig struct {
 	Prefix  string `yaml:"prefix"`
 }
 
+// Placements of embedded fields and interfaces. Inline embedded elements are
+// sorted among named ones by their type name, the others go before or after them.
+const (
+	EmbeddedFirst  = "first"
+	EmbeddedInline = "inline"
+	EmbeddedLast   = "last"
+)
+
+// FieldsConfig configures sorting of struct fields and interface methods.
+type FieldsConfig struct {
+	CheckConfig `yaml:",inline"`
+
+	Embedded string `yaml:"embedded"`
+}
+
+// Import section specifiers.
+const (
+	ImportSectionModule     = "module"
+	ImportSectionPrefix     = "prefix"
+	ImportSectionStd        = "std"
+	ImportSectionThirdParty = "third-party"
+)
+
+// ImportsConfig configures the layout of import blocks. Sections are listed in the
+// order their groups must appear: "std", "third-party", "module" (the current module
+// from go.mod) and "prefix(<path>)" for custom prefixes.
+type ImportsConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	Module   string `yaml:"module"`
+	Sections List   `yaml:"sections"`
+}
+
+// MapLiteralsConfig configures sorting of map literal keys. With ResolveConstants,
+// keys referring to constants, like http.MethodGet, are compared by their values
+// instead of their names, while Prefix still matches the names. With the
+// declaration order, maps keyed by constants follow the order the constants are
+// declared in.
+type MapLiteralsConfig struct {
+	CheckConfig `yaml:",inline"`
+
+	Order            string `yaml:"order"`
+	ResolveConstants bool   `yaml:"resolveConstants"`
+}
+
+// SliceElementsConfig configures sorting of slice and array literal elements.
+// Element order is often meaningful, so only literals whose element type or
+// target variable name matches one of the patterns are checked.
+type SliceElementsConfig struct {
+	CheckConfig `yaml:",inline"`
+
+	Types     Patterns `yaml:"types"`
+	Variables Patterns `yaml:"variables"`
+}
+
+// Element orders. The declaration order follows the order of struct fields for
+// struct literals and the order of constants in their const blocks for enum keys.
+const (
+	OrderAlphabetical = "alphabetical"
+	OrderDeclaration  = "declaration"
+)
+
+// StructLiteralsConfig configures sorting of keyed struct literal fields. With the
+// declaration order, fields follow the order of the struct declaration instead of
+// the alphabetical one.
+type StructLiteralsConfig struct {
+	CheckConfig `yaml:",inline"`
+
+	Order string `yaml:"order"`
+}
+
+// SwitchCasesConfig configures sorting of case clauses in expression switches whose
+// cases are all constants. The default clause keeps its place and switches with
+// fallthrough are never checked.
+type SwitchCasesConfig struct {
+	CheckConfig `yaml:",inline"`
+
+	Order string `yaml:"order"`
+}
+
+// TestCasesConfig configures sorting of table-driven test cases: elements of slice
+// literals of structs in _test.go files are sorted by the value of the Field struct field.
+type TestCasesConfig struct {
+	CheckConfig `yaml:",inline"`
+
+	Field string `yaml:"field"`
+}
+
+// VariadicArgsConfig configures sorting of variadic call arguments.
+type VariadicArgsConfig struct {
+	CheckConfig `yaml:",inline"`
+
+	// Functions lists functions and methods (see Patterns) whose variadic arguments
+	// are checked, e.g. errors.Join or (*github.com/x/y.Router).Use. All variadic
+	// functions are checked if it is empty.
+	Functions Patterns `yaml:"functions"`
+
+	// Exclude lists functions and methods whose variadic arguments are never checked,
+	// e.g. fmt.Printf, append or path/filepath.Join where order is semantic.
+	Exclude Patterns `yaml:"exclude"`
+
+	// Pairs lists functions (see Patterns) whose variadic arguments are alternating
+	// key/value pairs, like slog.Info("msg", "user", u, "attempt", n). Pairs are sorted
+	// by key, and attribute arguments like slog.String("k", v) count as a whole pair.
+	Pairs Patterns `yaml:"pairs"`
+
+	// Options lists functions (see Patterns) taking functional options, like
+	// server.New(WithTimeout(5), WithAddr(":80")). Call arguments are sorted by the name
+	// of the called option constructor only, ignoring its arguments.
+	Options Patterns `yaml:"options"`
+}
+
+// StructTagsConfig configures ordering of keys within struct tags. Keys listed
+// in Order come first in that order, the rest follow alphabetically.
+type StructTagsConfig struct {
+	CheckConfig `yaml:",inline"`
+
+	Order List `yaml:"order"`
+}
+
 type SortConfig struct {
 	FixModeEnabled bool   `yaml:"fix"`
 	GlobalPrefix   string `yaml:"prefix"`
 	IgnoreGroups   bool   `yaml:"ignoreGroups"`
 	Verbose        bool   `yaml:"verbose"`
 
-	Constants        *CheckConfig `yaml:"constants"`
-	InterfaceMethods *CheckConfig `yaml:"interfaceMethods"`
-	MapKeys          *CheckConfig `yaml:"mapKeys"`
-	StructFields     *CheckConfig `yaml:"structFields"`
-	Variables        *CheckConfig `yaml:"variables"`
-	VariadicArgs     *CheckConfig `yaml:"variadicArgs"`
+	// FixSideEffects enables fixes for groups whose values have side effects, like
+	// calls or channel receives. Sorting such groups changes the order the effects happen in.
+	FixSideEffects bool `yaml:"fixSideEffects"`
+
+	// VerifyFixes applies every suggested fix to a copy of its file and drops the
+	// fixes that do not parse or type-check.
+	VerifyFixes bool `yaml:"verifyFixes"`
+
+	Constants        *CheckConfig          `yaml:"constants"`
+	Imports          *ImportsConfig        `yaml:"imports"`
+	IndexedArrays    *CheckConfig          `yaml:"indexedArrays"`
+	InterfaceMethods *FieldsConfig         `yaml:"interfaceMethods"`
+	MapLiterals      *MapLiteralsConfig    `yaml:"mapLiterals"`
+	SliceElements    *SliceElementsConfig  `yaml:"sliceElements"`
+	StructFields     *FieldsConfig         `yaml:"structFields"`
+	StructLiterals   *StructLiteralsConfig `yaml:"structLiterals"`
+	StructTags       *StructTagsConfig     `yaml:"structTags"`
+	SwitchCases      *SwitchCasesConfig    `yaml:"switchCases"`
+	TestCases        *TestCasesConfig      `yaml:"testCases"`
+	UnionTerms       *CheckConfig          `yaml:"unionTerms"`
+	Variables        *CheckConfig          `yaml:"variables"`
+	VariadicArgs     *VariadicArgsConfig   `yaml:"variadicArgs"`
+
+	// Deprecated keys, moved to the keys replacing them by ApplyDeprecated.
+	CompositeLiterals *CompositeLiteralsConfig `yaml:"compositeLiterals"`
+	// Deprecated: MapKeys configured all keyed composite literals, use MapLiterals,
+	// StructLiterals and IndexedArrays.
+	MapKeys *CheckConfig `yaml:"mapKeys"`
 }
 
 func New() *SortConfig {
 	return &SortConfig{
 		FixModeEnabled: Default[bool](FlagFix), GlobalPrefix: Default[string](FlagFilterPrefix), IgnoreGroups: Default[bool](FlagIgnoreGroups), Verbose: Default[bool](FlagVerbose),
+		FixSideEffects: Default[bool](FlagFixSideEffects), VerifyFixes: Default[bool](FlagVerifyFixes),
 
 		Constants: &CheckConfig{
 			Enabled: Default[bool](FlagConstants),
 			Prefix:  Default[string](FlagConstantsPrefix),
 		},
+		Imports: &ImportsConfig{
+			Enabled:  Default[bool](FlagImports),
+			Module:   Default[string](FlagImportsModule),
+			Sections: List{ImportSectionStd, ImportSectionThirdParty, ImportSectionModule},
+		},
 		Variables: &CheckConfig{
 			Enabled: Default[bool](FlagVariables),
 			Prefix:  Default[string](FlagVariablesPrefix),
 		},
-		StructFields: &CheckConfig{
-			Enabled: Default[bool](FlagStructFields),
-			Prefix:  Default[string](FlagStructFieldsPrefix),
+		StructFields: &FieldsConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagStructFields),
+				Prefix:  Default[string](FlagStructFieldsPrefix),
+			},
+			Embedded: Default[string](FlagStructFieldsEmbedded),
+		},
+		StructTags: &StructTagsConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagStructTags),
+				Prefix:  Default[string](FlagStructTagsPrefix),
+			},
+		},
+		InterfaceMethods: &FieldsConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagInterfaceMethods),
+				Prefix:  Default[string](FlagInterfaceMethodsPrefix),
+			},
+			Embedded: Default[string](FlagInterfaceMethodsEmbedded),
+		},
+		SwitchCases: &SwitchCasesConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagSwitchCases),
+				Prefix:  Default[string](FlagSwitchCasesPrefix),
+			},
+			Order: Default[string](FlagSwitchCasesOrder),
+		},
+		TestCases: &TestCasesConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagTestCases),
+				Prefix:  Default[string](FlagTestCasesPrefix),
+			},
+			Field: Default[string](FlagTestCasesField),
+		},
+		UnionTerms: &CheckConfig{
+			Enabled: Default[bool](FlagUnionTerms),
+			Prefix:  Default[string](FlagUnionTermsPrefix),
+		},
+		VariadicArgs: &VariadicArgsConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagVariadicArgs),
+				Prefix:  Default[string](FlagVariadicArgsPrefix),
+			},
+			Pairs: Patterns{
+				"log/slog.*",
+				"(*log/slog.Logger).*",
+				"(*go.uber.org/zap.SugaredLogger).*w",
+				"(*go.uber.org/zap.SugaredLogger).With",
+			},
+		},
+		MapLiterals: &MapLiteralsConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagMapLiterals),
+				Prefix:  Default[string](FlagMapLiteralsPrefix),
+			},
+			Order:            Default[string](FlagMapLiteralsOrder),
+			ResolveConstants: Default[bool](FlagMapLiteralsResolveConstants),
 		},
-		InterfaceMethods: &CheckConfig{
-			Enabled: Default[bool](FlagInterfaceMethods),
-			Prefix:  Default[string](FlagInterfaceMethodsPrefix),
+		StructLiterals: &StructLiteralsConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagStructLiterals),
+				Prefix:  Default[string](FlagStructLiteralsPrefix),
+			},
+			Order: Default[string](FlagStructLiteralsOrder),
 		},
-		VariadicArgs: &CheckConfig{
-			Enabled: Default[bool](FlagVariadicArgs),
-			Prefix:  Default[string](FlagVariadicArgsPrefix),
+		IndexedArrays: &CheckConfig{
+			Enabled: Default[bool](FlagIndexedArrays),
+			Prefix:  Default[string](FlagIndexedArraysPrefix),
 		},
-		MapKeys: &CheckConfig{
-			Enabled: Default[bool](FlagMapKeys),
-			Prefix:  Default[string](FlagMapKeysPrefix),
+		SliceElements: &SliceElementsConfig{
+			CheckConfig: CheckConfig{
+				Enabled: Default[bool](FlagSliceElements),
+				Prefix:  Default[string](FlagSliceElementsPrefix),
+			},
 		},
 	}
 }
@@ -1,7 +1,13 @@
 package config
 
 var defaults = map[string]any{
-	FlagConstants: true, FlagInterfaceMethods: true, FlagMapKeys: true, FlagStructFields: true, FlagVariables: true,
+	FlagConstants: true, FlagIndexedArrays: true, FlagInterfaceMethods: true, FlagMapLiterals: true, FlagSliceElements: true, FlagStructFields: true, FlagStructLiterals: true, FlagUnionTerms: true, FlagVariables: true,
+
+	FlagInterfaceMethodsEmbedded: EmbeddedInline, FlagStructFieldsEmbedded: EmbeddedInline,
+
+	FlagMapLiteralsOrder: OrderAlphabetical, FlagStructLiteralsOrder: OrderAlphabetical, FlagSwitchCasesOrder: OrderAlphabetical,
+
+	FlagTestCasesField: "name",
 }
 
 func Default[T any](param string) T {
@@ -0,0 +1,58 @@
+package config
+
+// CompositeLiteralsConfig is the former configuration of the keyed composite literal
+// order, which only applied to struct literals.
+//
+// Deprecated: use StructLiteralsConfig.Order.
+type CompositeLiteralsConfig struct {
+	Order string `yaml:"order"`
+}
+
+// ApplyDeprecated moves settings given under deprecated keys to the keys replacing
+// them, so that existing configs keep working. A deprecated setting is ignored if
+// the replacing one is changed from its default too.
+func (c *SortConfig) ApplyDeprecated() {
+	// mapKeys covered every keyed composite literal before it was split
+	if c.MapKeys != nil {
+		checks := map[string]*CheckConfig{
+			FlagIndexedArrays:  c.IndexedArrays,
+			FlagMapLiterals:    c.mapLiteralsCheck(),
+			FlagStructLiterals: c.structLiteralsCheck(),
+		}
+
+		for flag, check := range checks {
+			if check == nil {
+				continue
+			}
+
+			if check.Enabled == Default[bool](flag) {
+				check.Enabled = c.MapKeys.Enabled
+			}
+			if check.Prefix == "" {
+				check.Prefix = c.MapKeys.Prefix
+			}
+		}
+	}
+
+	if c.CompositeLiterals != nil && c.CompositeLiterals.Order != "" && c.StructLiterals != nil {
+		if c.StructLiterals.Order == Default[string](FlagStructLiteralsOrder) {
+			c.StructLiterals.Order = c.CompositeLiterals.Order
+		}
+	}
+}
+
+func (c *SortConfig) mapLiteralsCheck() *CheckConfig {
+	if c.MapLiterals == nil {
+		return nil
+	}
+
+	return &c.MapLiterals.CheckConfig
+}
+
+func (c *SortConfig) structLiteralsCheck() *CheckConfig {
+	if c.StructLiterals == nil {
+		return nil
+	}
+
+	return &c.StructLiterals.CheckConfig
+}
@@ -0,0 +1,35 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/sortir/internal/config"
+)
+
+func TestApplyDeprecated(t *testing.T) {
+	t.Run("composite literals order", func(t *testing.T) {
+		cfg := config.New()
+		cfg.CompositeLiterals = &config.CompositeLiteralsConfig{Order: config.OrderDeclaration}
+		cfg.ApplyDeprecated()
+		require.Equal(t, config.OrderDeclaration, cfg.StructLiterals.Order)
+	})
+
+	t.Run("replacing key takes precedence", func(t *testing.T) {
+		cfg := config.New()
+		cfg.CompositeLiterals = &config.CompositeLiteralsConfig{Order: config.OrderAlphabetical}
+		cfg.StructLiterals.Order = config.OrderDeclaration
+		cfg.ApplyDeprecated()
+		require.Equal(t, config.OrderDeclaration, cfg.StructLiterals.Order)
+	})
+
+	t.Run("map keys", func(t *testing.T) {
+		cfg := config.New()
+		cfg.MapKeys = &config.CheckConfig{Enabled: false, Prefix: "Key"}
+		cfg.ApplyDeprecated()
+
+		for _, check := range []config.CheckConfig{cfg.MapLiterals.CheckConfig, cfg.StructLiterals.CheckConfig, *cfg.IndexedArrays} {
+			require.Equal(t, config.CheckConfig{Enabled: false, Prefix: "Key"}, check)
+		}
+	})
+}
@@ -1,26 +1,72 @@
 package config
 
 const (
-	FlagFilterPrefix = "filter-prefix"
-	FlagFix          = "fix"
-	FlagIgnoreGroups = "ignore-groups"
-	FlagVerbose      = "verbose"
+	FlagFilterPrefix   = "filter-prefix"
+	FlagFix            = "fix"
+	FlagFixSideEffects = "fix-side-effects"
+	FlagIgnoreGroups   = "ignore-groups"
+	FlagVerbose        = "verbose"
+	FlagVerifyFixes    = "verify-fixes"
 
 	FlagConstants       = "constants"
 	FlagConstantsPrefix = "constants.prefix"
 
+	FlagImports         = "imports"
+	FlagImportsModule   = "imports.module"
+	FlagImportsSections = "imports.sections"
+
 	FlagVariables       = "variables"
 	FlagVariablesPrefix = "variables.prefix"
 
-	FlagStructFields       = "struct-fields"
-	FlagStructFieldsPrefix = "struct-fields.prefix"
+	FlagStructFields         = "struct-fields"
+	FlagStructFieldsEmbedded = "struct-fields.embedded"
+	FlagStructFieldsPrefix   = "struct-fields.prefix"
+
+	FlagStructTags       = "struct-tags"
+	FlagStructTagsOrder  = "struct-tags.order"
+	FlagStructTagsPrefix = "struct-tags.prefix"
+
+	FlagInterfaceMethods         = "interface-methods"
+	FlagInterfaceMethodsEmbedded = "interface-methods.embedded"
+	FlagInterfaceMethodsPrefix   = "interface-methods.prefix"
+
+	FlagSwitchCases       = "switch-cases"
+	FlagSwitchCasesOrder  = "switch-cases.order"
+	FlagSwitchCasesPrefix = "switch-cases.prefix"
+
+	FlagTestCases       = "test-cases"
+	FlagTestCasesField  = "test-cases.field"
+	FlagTestCasesPrefix = "test-cases.prefix"
+
+	FlagUnionTerms       = "union-terms"
+	FlagUnionTermsPrefix = "union-terms.prefix"
+
+	FlagVariadicArgs          = "variadic-args"
+	FlagVariadicArgsExclude   = "variadic-args.exclude"
+	FlagVariadicArgsFunctions = "variadic-args.functions"
+	FlagVariadicArgsOptions   = "variadic-args.options"
+	FlagVariadicArgsPairs     = "variadic-args.pairs"
+	FlagVariadicArgsPrefix    = "variadic-args.prefix"
+
+	FlagMapLiterals                 = "map-literals"
+	FlagMapLiteralsOrder            = "map-literals.order"
+	FlagMapLiteralsPrefix           = "map-literals.prefix"
+	FlagMapLiteralsResolveConstants = "map-literals.resolve-constants"
+
+	FlagStructLiterals       = "struct-literals"
+	FlagStructLiteralsOrder  = "struct-literals.order"
+	FlagStructLiteralsPrefix = "struct-literals.prefix"
 
-	FlagInterfaceMethods       = "interface-methods"
-	FlagInterfaceMethodsPrefix = "interface-methods.prefix"
+	FlagIndexedArrays       = "indexed-arrays"
+	FlagIndexedArraysPrefix = "indexed-arrays.prefix"
 
-	FlagVariadicArgs       = "variadic-args"
-	FlagVariadicArgsPrefix = "variadic-args.prefix"
+	FlagSliceElements          = "slice-elements"
+	FlagSliceElementsPrefix    = "slice-elements.prefix"
+	FlagSliceElementsTypes     = "slice-elements.types"
+	FlagSliceElementsVariables = "slice-elements.variables"
 
-	FlagMapKeys       = "map-keys"
-	FlagMapKeysPrefix = "map-keys.prefix"
+	// Deprecated flags, see SortConfig.ApplyDeprecated.
+	FlagCompositeLiteralsOrder = "composite-literals.order"
+	FlagMapKeys                = "map-keys"
+	FlagMapKeysPrefix          = "map-keys.prefix"
 )
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"path"
+	"strings"
+)
+
+// List is a list of values that can be set from a comma-separated command line flag.
+type List []string
+
+func (l *List) String() string {
+	if l == nil {
+		return ""
+	}
+
+	return strings.Join(*l, ",")
+}
+
+func (l *List) Set(value string) error {
+	for item := range strings.SplitSeq(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			*l = append(*l, item)
+		}
+	}
+
+	return nil
+}
+
+// Patterns is a list of glob patterns (see path.Match) that can be set from
+// a comma-separated command line flag.
+type Patterns []string
+
+func (p *Patterns) String() string {
+	if p == nil {
+		return ""
+	}
+
+	return strings.Join(*p, ",")
+}
+
+func (p *Patterns) Set(value string) error {
+	for pattern := range strings.SplitSeq(value, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			return err
+		}
+
+		*p = append(*p, pattern)
+	}
+
+	return nil
+}
+
+// Match reports whether any of the names matches any of the patterns.
+func (p Patterns) Match(names ...string) bool {
+	for _, pattern := range p {
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+
+			if pattern == name {
+				return true
+			}
+
+			if ok, err := path.Match(pattern, name); err == nil && ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,42 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/sortir/internal/config"
+)
+
+func TestPatterns(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		var patterns config.Patterns
+
+		require.NoError(t, patterns.Set("allowed*, *.Kind,,"))
+		require.NoError(t, patterns.Set("string"))
+		require.Equal(t, config.Patterns{"allowed*", "*.Kind", "string"}, patterns)
+		require.Equal(t, "allowed*,*.Kind,string", patterns.String())
+	})
+
+	t.Run("set malformed", func(t *testing.T) {
+		var patterns config.Patterns
+
+		require.Error(t, patterns.Set("[a-"))
+		require.Empty(t, patterns)
+	})
+
+	t.Run("match", func(t *testing.T) {
+		patterns := config.Patterns{"allowed*", "*.Kind"}
+
+		require.True(t, patterns.Match("allowedHosts"))
+		require.True(t, patterns.Match("", "pkg.Kind"))
+		require.False(t, patterns.Match("github.com/x/pkg.Kind"))
+		require.False(t, patterns.Match("steps"))
+		require.False(t, patterns.Match(""))
+	})
+
+	t.Run("match empty", func(t *testing.T) {
+		var patterns config.Patterns
+
+		require.False(t, patterns.Match("anything"))
+	})
+}
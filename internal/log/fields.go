@@ -7,19 +7,29 @@ const (
 	FieldDiagnostic       = "diagnostic"
 	FieldDiagnosticsCount = "diagnostics_count"
 	FieldElement          = "element"
+	FieldElementsCount    = "elements_count"
 	FieldEnabled          = "enabled"
+	FieldError            = "error"
+	FieldField            = "field"
 	FieldFieldsCount      = "fields_count"
+	FieldFixFailures      = "fix_failures"
+	FieldFunction         = "function"
 	FieldGlobalPrefix     = "global_prefix"
 	FieldGroupIndex       = "group_index"
 	FieldGroupSize        = "group_size"
 	FieldGroupsCount      = "groups_count"
 	FieldIgnoreGroups     = "ignore_groups"
 	FieldKeyValueCount    = "key_value_count"
+	FieldKind             = "kind"
 	FieldMethodsCount     = "methods_count"
 	FieldNodeType         = "node_type"
+	FieldOrder            = "order"
 	FieldPackage          = "package"
 	FieldPosition         = "position"
 	FieldPrefix           = "prefix"
 	FieldPrevious         = "previous"
+	FieldReason           = "reason"
+	FieldSections         = "sections"
 	FieldSpecsCount       = "specs_count"
+	FieldTermsCount       = "terms_count"
 )